@@ -0,0 +1,254 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/auditlog"
+	"testMigrationEntgo/ent/privacy"
+	"testMigrationEntgo/ent/schema"
+	"testMigrationEntgo/ent/schema/schematype"
+
+	"github.com/google/uuid"
+)
+
+// auditedMutation is implemented by the mutations of every entity.
+type auditedMutation interface {
+	ent.Mutation
+	Tx() (*ent.Tx, error)
+}
+
+// idMutation is implemented by the mutations of the entities with ids of
+// type T. Edge schemas, like Follow, have composite ids and are not audited.
+type idMutation[T any] interface {
+	ID() (T, bool)
+	IDs(context.Context) ([]T, error)
+}
+
+// mutationIDs returns the ids of the rows m changes, formatted as text. It
+// returns nil for creates, whose ids are only known once they are done.
+func mutationIDs(ctx context.Context, m ent.Mutation) ([]string, error) {
+	if m.Op().Is(ent.OpCreate) {
+		return nil, nil
+	}
+	switch m := m.(type) {
+	case idMutation[int]:
+		return formatIDs(m.IDs(ctx))
+	case idMutation[uuid.UUID]:
+		return formatIDs(m.IDs(ctx))
+	}
+	return nil, nil
+}
+
+// createdID returns the id of the row created by m, formatted as text. Rows
+// skipped by an upsert get no id.
+func createdID(m ent.Mutation) (string, bool) {
+	switch m := m.(type) {
+	case idMutation[int]:
+		id, ok := m.ID()
+		return strconv.Itoa(id), ok && id != 0
+	case idMutation[uuid.UUID]:
+		id, ok := m.ID()
+		return id.String(), ok && id != uuid.Nil
+	}
+	return "", false
+}
+
+func formatIDs[T any](ids []T, err error) ([]string, error) {
+	if err != nil {
+		return nil, err
+	}
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = fmt.Sprint(id)
+	}
+	return s, nil
+}
+
+// errAuditNotInTx is returned by audited mutations run outside of a
+// transaction, see inTx.
+var errAuditNotInTx = errors.New("audited mutations must run in a transaction")
+
+// auditHook returns a mutation hook that writes an AuditLog for every
+// entity a mutation changes, in the transaction of the change. The logs
+// are written by the last hook of the audited schemas, once the rows the
+// mutation changes are known, see schema.WithAudit.
+func auditHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			return next.Mutate(schema.WithAudit(ctx, writeAudit), m)
+		})
+	}
+}
+
+// writeAudit runs m and writes an AuditLog for every entity it changes. Ids and
+// old values are loaded in the transaction of m before it changes them,
+// including soft-deleted rows, as updating them is allowed.
+func writeAudit(ctx context.Context, op ent.Op, m ent.Mutation, next ent.Mutator) (ent.Value, error) {
+	am, ok := m.(auditedMutation)
+	if !ok {
+		return next.Mutate(ctx, m)
+	}
+	tx, err := am.Tx()
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", op, m.Type(), errAuditNotInTx)
+	}
+	ids, err := mutationIDs(schema.IncludeDeleted(ctx), m)
+	if err != nil {
+		return nil, err
+	}
+	changes := auditChanges(schema.IncludeDeleted(ctx), m)
+	v, err := next.Mutate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	if id, ok := createdID(m); ok && m.Op().Is(ent.OpCreate) {
+		ids = []string{id}
+	}
+	if len(ids) == 0 {
+		return v, nil
+	}
+
+	actor := auditActor(ctx)
+	err = tx.Client().AuditLog.MapCreateBulk(ids, func(c *ent.AuditLogCreate, i int) {
+		c.SetEntityType(m.Type()).
+			SetEntityID(ids[i]).
+			SetOp(op.String()).
+			SetChanges(changes)
+		if actor != "" {
+			c.SetActor(actor)
+		}
+	}).Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("while writing audit log: %w", err)
+	}
+	return v, nil
+}
+
+// auditActor returns who the mutations of ctx run on behalf of: the id of
+// the viewer, admin for admins acting as no one, or system for System
+// contexts.
+func auditActor(ctx context.Context) string {
+	if v, ok := schema.ViewerFromContext(ctx); ok {
+		if v.ID != uuid.Nil {
+			return v.ID.String()
+		}
+		if v.Admin {
+			return "admin"
+		}
+	}
+	if d, _ := privacy.DecisionFromContext(ctx); errors.Is(d, privacy.Allow) {
+		return "system"
+	}
+	return ""
+}
+
+// auditChanges returns the fields set or cleared by m, along with their old
+// values for single row updates. Old values must be loaded before m runs.
+func auditChanges(ctx context.Context, m ent.Mutation) map[string]schematype.FieldChange {
+	changes := make(map[string]schematype.FieldChange)
+	old := func(name string) any {
+		if !m.Op().Is(ent.OpUpdateOne) {
+			return nil
+		}
+		v, err := m.OldField(ctx, name)
+		if err != nil {
+			return nil
+		}
+		return v
+	}
+	for _, name := range m.Fields() {
+		v, _ := m.Field(name)
+		changes[name] = schematype.FieldChange{Old: old(name), New: v}
+	}
+	for _, name := range m.ClearedFields() {
+		changes[name] = schematype.FieldChange{Old: old(name)}
+	}
+	return changes
+}
+
+// inTx runs fn with a client whose statements run in a transaction: cli if
+// it is bound to one already, or the client of a new one, committed once fn
+// succeeds. Audited mutations must run in a transaction.
+func inTx(ctx context.Context, cli *ent.Client, fn func(*ent.Client) error) error {
+	tx, err := cli.Tx(ctx)
+	if errors.Is(err, ent.ErrTxStarted) {
+		return fn(cli)
+	}
+	if err != nil {
+		return fmt.Errorf("while starting transaction: %w", err)
+	}
+	if err := fn(tx.Client()); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// inTxEntity runs fn as inTx does and returns the entity it returns, detached
+// from the transaction inTx started, if any, so that it can still be used
+// once the transaction is committed.
+func inTxEntity[T interface{ Unwrap() T }](ctx context.Context, cli *ent.Client, fn func(*ent.Client) (T, error)) (T, error) {
+	var v T
+	err := inTx(ctx, cli, func(txc *ent.Client) (err error) {
+		if v, err = fn(txc); err == nil && txc != cli {
+			v = v.Unwrap()
+		}
+		return err
+	})
+	return v, err
+}
+
+// rollback rolls tx back after err and returns err, along with the rollback
+// error if any.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: while rolling back: %v", err, rerr)
+	}
+	return err
+}
+
+// auditTrail returns the audit logs of an entity, oldest first. The id is
+// formatted as text, as it is in the logs.
+func auditTrail(ctx context.Context, cli *ent.Client, entityType string, id any) ([]*ent.AuditLog, error) {
+	return cli.AuditLog.Query().
+		Where(auditlog.EntityType(entityType), auditlog.EntityID(fmt.Sprint(id))).
+		Order(ent.Asc(auditlog.FieldCreatedAt), ent.Asc(auditlog.FieldID)).
+		All(ctx)
+}
+
+// runAudit implements the audit command, printing the audit trail of an
+// entity
+func runAudit(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("audit", flag.ExitOnError)
+	s.register(fs)
+	entityType := fs.String("type", ent.TypeBlog, "type of the entity, as in the logs")
+	id := fs.String("id", "", "id of the entity")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(ctx, client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	logs, err := auditTrail(ctx, client, *entityType, *id)
+	if err != nil {
+		log.Fatalf("failed loading audit trail: %v", err)
+	}
+	for _, l := range logs {
+		changes, err := json.Marshal(l.Changes)
+		if err != nil {
+			log.Fatalf("failed formatting changes: %v", err)
+		}
+		fmt.Printf("%s\t%s\t%s\t%s\n", l.CreatedAt.Format(time.RFC3339), l.Op, l.Actor, changes)
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/schema"
+)
+
+func TestAuditRestrictedUpdate(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := testTenant(t, cli, "t")
+	a, b := testUser(t, ctx, cli, "a@example.com"), testUser(t, ctx, cli, "b@example.com")
+	own, other := testBlog(t, ctx, cli, a, "own"), testBlog(t, ctx, cli, b, "other")
+	cli.Use(auditHook())
+	ctx = schema.WithViewer(ctx, schema.Viewer{ID: a.ID})
+
+	// The policy restricts the update to the blogs of the viewer, which
+	// are the only ones logged.
+	err := inTx(ctx, cli, func(cli *ent.Client) error {
+		return cli.Blog.Update().SetBody("edited").Exec(ctx)
+	})
+	if err != nil {
+		t.Fatalf("updating blogs: %v", err)
+	}
+	logs, err := auditTrail(ctx, cli, ent.TypeBlog, own.ID)
+	if err != nil {
+		t.Fatalf("auditTrail: %v", err)
+	}
+	if len(logs) != 1 || logs[0].Op != ent.OpUpdate.String() || logs[0].Actor != a.ID.String() {
+		t.Errorf("audit trail of the own blog: got %+v, want an update by %s", logs, a.ID)
+	}
+	logs, err = auditTrail(ctx, cli, ent.TypeBlog, other.ID)
+	if err != nil {
+		t.Fatalf("auditTrail: %v", err)
+	}
+	if len(logs) != 0 {
+		t.Errorf("audit trail of the other blog: got %+v, want none", logs)
+	}
+}
+
+func TestAuditOldValues(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := testTenant(t, cli, "t")
+	a := testUser(t, ctx, cli, "a@example.com")
+	b := testBlog(t, ctx, cli, a, "before")
+	cli.Use(auditHook())
+	ctx = schema.WithViewer(ctx, schema.Viewer{ID: a.ID})
+
+	if _, err := editBlog(ctx, cli, b.ID, b.Version, "after", b.Body); err != nil {
+		t.Fatalf("editBlog: %v", err)
+	}
+	err := inTx(ctx, cli, func(cli *ent.Client) error {
+		return cli.Blog.DeleteOneID(b.ID).Exec(ctx)
+	})
+	if err != nil {
+		t.Fatalf("deleting blog: %v", err)
+	}
+	logs, err := auditTrail(ctx, cli, ent.TypeBlog, b.ID)
+	if err != nil {
+		t.Fatalf("auditTrail: %v", err)
+	}
+	if len(logs) != 2 {
+		t.Fatalf("audit trail: got %d logs, want 2", len(logs))
+	}
+	if c := logs[0].Changes[blog.FieldTitle]; c.Old != "before" || c.New != "after" {
+		t.Errorf("title change: got %+v, want before to after", c)
+	}
+	// Soft deletions run as updates, but are logged as deletions.
+	if op := logs[1].Op; op != ent.OpDeleteOne.String() {
+		t.Errorf("deletion op: got %s, want %s", op, ent.OpDeleteOne)
+	}
+	if _, ok := logs[1].Changes[blog.FieldDeletedAt]; !ok {
+		t.Errorf("deletion changes: got %+v, want deleted_at", logs[1].Changes)
+	}
+}
+
+func TestAuditNotInTx(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := testTenant(t, cli, "t")
+	a := testUser(t, ctx, cli, "a@example.com")
+	cli.Use(auditHook())
+
+	err := cli.User.UpdateOneID(a.ID).SetName("renamed").Exec(schema.System(ctx))
+	if !errors.Is(err, errAuditNotInTx) {
+		t.Errorf("updating outside of a transaction: got error %v, want %v", err, errAuditNotInTx)
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/schema"
+	"testMigrationEntgo/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// queryBlogs returns a query on the blogs in any of the given statuses. Only
+// published blogs are returned when no status is given.
+func queryBlogs(cli *ent.Client, statuses ...blog.Status) *ent.BlogQuery {
+	if len(statuses) == 0 {
+		statuses = []blog.Status{blog.StatusPublished}
+	}
+	return cli.Blog.Query().Where(blog.StatusIn(statuses...))
+}
+
+// editBlog changes the title and body of a blog, provided it is still at
+// the version the editor read it at. It fails with a VersionConflictError
+// otherwise.
+func editBlog(ctx context.Context, cli *ent.Client, id uuid.UUID, version int, title, body string) (*ent.Blog, error) {
+	return inTxEntity(ctx, cli, func(cli *ent.Client) (*ent.Blog, error) {
+		return cli.Blog.UpdateOneID(id).
+			Where(blog.Version(version)).
+			SetTitle(title).
+			SetBody(body).
+			Save(ctx)
+	})
+}
+
+// publishBlog publishes a draft or archived blog, provided it is still at
+// the given version, as editBlog does.
+func publishBlog(ctx context.Context, cli *ent.Client, id uuid.UUID, version int) (*ent.Blog, error) {
+	return setBlogStatus(ctx, cli, id, version, blog.StatusPublished)
+}
+
+// archiveBlog archives a draft or published blog, provided it is still at
+// the given version, as editBlog does.
+func archiveBlog(ctx context.Context, cli *ent.Client, id uuid.UUID, version int) (*ent.Blog, error) {
+	return setBlogStatus(ctx, cli, id, version, blog.StatusArchived)
+}
+
+// unarchiveBlog moves an archived blog back to draft, provided it is still
+// at the given version, as editBlog does.
+func unarchiveBlog(ctx context.Context, cli *ent.Client, id uuid.UUID, version int) (*ent.Blog, error) {
+	return setBlogStatus(ctx, cli, id, version, blog.StatusDraft)
+}
+
+func setBlogStatus(ctx context.Context, cli *ent.Client, id uuid.UUID, version int, status blog.Status) (*ent.Blog, error) {
+	return inTxEntity(ctx, cli, func(cli *ent.Client) (*ent.Blog, error) {
+		return cli.Blog.UpdateOneID(id).
+			Where(blog.Version(version)).
+			SetStatus(status).
+			Save(ctx)
+	})
+}
+
+// blogBySlug returns the blog with the given slug written by author, which
+// is either the email or the name of the author. As names are not unique,
+// looking up by name fails with a NotSingularError when several authors
+// with that name have a blog with that slug.
+func blogBySlug(ctx context.Context, cli *ent.Client, author, slug string) (*ent.Blog, error) {
+	by := user.Name(author)
+	if strings.Contains(author, "@") {
+		by = user.Email(schema.NormalizeEmail(author))
+	}
+	return cli.Blog.Query().
+		Where(blog.Slug(slug), blog.HasAuthorWith(by)).
+		Only(ctx)
+}
+
+// runBlogs implements the blogs command, listing the blogs of a tenant
+func runBlogs(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("blogs", flag.ExitOnError)
+	s.register(fs)
+	statuses := fs.String("status", string(blog.StatusPublished), "comma separated statuses of the blogs to list")
+	anyTags := fs.String("any-tags", "", "comma separated tags, blogs having any of them are listed")
+	allTags := fs.String("all-tags", "", "comma separated tags, blogs having all of them are listed")
+	search := fs.String("search", "", "search string, matching blogs are listed best ranked first")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(ctx, client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	q := queryBlogs(client, parseStatuses(*statuses)...)
+	if *anyTags != "" {
+		q.Where(hasAnyTag(strings.Split(*anyTags, ",")...))
+	}
+	if *allTags != "" {
+		q.Where(hasAllTags(strings.Split(*allTags, ",")...))
+	}
+	if *search != "" {
+		results, err := searchBlogs(ctx, q, *search)
+		if err != nil {
+			log.Fatalf("failed searching blogs: %v", err)
+		}
+		for _, r := range results {
+			fmt.Printf("%s\t%.3f\t%s\n\t%s\n", r.ID, r.Rank, r.TitleSnippet, r.BodySnippet)
+		}
+		return
+	}
+	blogs, err := withReactionCounts(ctx, client, q.Order(ent.Asc(blog.FieldCreatedAt)))
+	if err != nil {
+		log.Fatalf("failed listing blogs: %v", err)
+	}
+	for _, b := range blogs {
+		fmt.Printf("%s\tv%d\t%s\t%s\t%v\n", b.ID, b.Version, b.Status, b.Title, b.Reactions)
+	}
+}
+
+// parseStatuses parses comma separated blog statuses, and exits if any is
+// invalid
+func parseStatuses(s string) []blog.Status {
+	var statuses []blog.Status
+	for _, st := range strings.Split(s, ",") {
+		if err := blog.StatusValidator(blog.Status(st)); err != nil {
+			log.Fatalf("invalid status %q", st)
+		}
+		statuses = append(statuses, blog.Status(st))
+	}
+	return statuses
+}
+
+// runPublish implements the publish command
+func runPublish(ctx context.Context, args []string) {
+	runStatusChange(ctx, "publish", args, publishBlog)
+}
+
+// runArchive implements the archive command
+func runArchive(ctx context.Context, args []string) {
+	runStatusChange(ctx, "archive", args, archiveBlog)
+}
+
+// runUnarchive implements the unarchive command
+func runUnarchive(ctx context.Context, args []string) {
+	runStatusChange(ctx, "unarchive", args, unarchiveBlog)
+}
+
+func runStatusChange(ctx context.Context, command string, args []string, change func(context.Context, *ent.Client, uuid.UUID, int) (*ent.Blog, error)) {
+	var s sessionFlags
+	fs := flag.NewFlagSet(command, flag.ExitOnError)
+	s.register(fs)
+	id := uuidFlag(fs, "id", "id of the blog to "+command)
+	version := fs.Int("version", 0, "version of the blog the change was decided at")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(withPrimary(ctx), client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	b, err := change(ctx, client, *id, *version)
+	if err != nil {
+		log.Fatalf("failed to %s blog %s: %v", command, *id, err)
+	}
+	log.Printf("blog %s is %s at version %d", b.ID, b.Status, b.Version)
+}
+
+// runBlog implements the blog command, printing the blog with the given
+// slug written by an author
+func runBlog(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("blog", flag.ExitOnError)
+	s.register(fs)
+	author := fs.String("author", "", "email or name of the author")
+	slug := fs.String("slug", "", "slug of the blog")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(ctx, client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	b, err := blogBySlug(ctx, client, *author, *slug)
+	if err != nil {
+		log.Fatalf("failed looking up blog: %v", err)
+	}
+	fmt.Printf("%s\tv%d\t%s\n%s\n\n%s\n", b.ID, b.Version, b.Status, b.Title, b.Body)
+}
+
+// runEdit implements the edit command, changing the title or body of a blog
+func runEdit(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("edit", flag.ExitOnError)
+	s.register(fs)
+	id := uuidFlag(fs, "id", "id of the blog to edit")
+	version := fs.Int("version", 0, "version of the blog the edit was made at")
+	title := fs.String("title", "", "new title, unchanged if empty")
+	body := fs.String("body", "", "new body, unchanged if empty")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(withPrimary(ctx), client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	if *title == "" || *body == "" {
+		b, err := client.Blog.Get(ctx, *id)
+		if err != nil {
+			log.Fatalf("failed loading blog %s: %v", *id, err)
+		}
+		if *title == "" {
+			*title = b.Title
+		}
+		if *body == "" {
+			*body = b.Body
+		}
+	}
+	b, err := editBlog(ctx, client, *id, *version, *title, *body)
+	if ent.IsVersionConflict(err) {
+		log.Fatalf("blog %s changed since version %d, edit it again", *id, *version)
+	}
+	if err != nil {
+		log.Fatalf("failed editing blog %s: %v", *id, err)
+	}
+	log.Printf("blog %s is at version %d", b.ID, b.Version)
+}
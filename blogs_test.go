@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/privacy"
+	"testMigrationEntgo/ent/schema"
+)
+
+func TestSetBlogStatus(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := testTenant(t, cli, "t")
+	author := testUser(t, ctx, cli, "a@example.com")
+	b := testBlog(t, ctx, cli, author, "post")
+	ctx = schema.WithViewer(ctx, schema.Viewer{ID: author.ID})
+
+	b, err := publishBlog(ctx, cli, b.ID, b.Version)
+	if err != nil {
+		t.Fatalf("publishBlog: %v", err)
+	}
+	if b.Status != blog.StatusPublished || b.PublishedAt == nil {
+		t.Errorf("publishBlog: got status %s, published at %v", b.Status, b.PublishedAt)
+	}
+	if _, err := unarchiveBlog(ctx, cli, b.ID, b.Version); !ent.IsValidationError(err) {
+		t.Errorf("unarchiveBlog of a published blog: got error %v, want a validation error", err)
+	}
+}
+
+func TestBulkStatusUpdate(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := schema.System(testTenant(t, cli, "t"))
+	author := testUser(t, ctx, cli, "a@example.com")
+	draft := testBlog(t, ctx, cli, author, "draft")
+	published := testBlog(t, ctx, cli, author, "published")
+	if err := published.Update().SetStatus(blog.StatusPublished).Exec(ctx); err != nil {
+		t.Fatalf("publishing: %v", err)
+	}
+
+	// Published blogs can't go back to draft, so the whole update fails.
+	err := cli.Blog.Update().SetStatus(blog.StatusDraft).Exec(ctx)
+	if !ent.IsValidationError(err) {
+		t.Fatalf("moving all blogs to draft: got error %v, want a validation error", err)
+	}
+	// Blogs already archived are left alone.
+	if err := cli.Blog.UpdateOneID(draft.ID).SetStatus(blog.StatusArchived).Exec(ctx); err != nil {
+		t.Fatalf("archiving: %v", err)
+	}
+	archivedAt := cli.Blog.GetX(ctx, draft.ID).ArchivedAt
+	n, err := cli.Blog.Update().SetStatus(blog.StatusArchived).Save(ctx)
+	if err != nil {
+		t.Fatalf("archiving all blogs: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("archiving all blogs: got %d blogs archived, want 1", n)
+	}
+	if got := cli.Blog.GetX(ctx, draft.ID).ArchivedAt; !got.Equal(*archivedAt) {
+		t.Errorf("archived at: got %v, want %v", got, archivedAt)
+	}
+}
+
+func TestStaleUpdate(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := testTenant(t, cli, "t")
+	author := testUser(t, ctx, cli, "a@example.com")
+	stale := testBlog(t, ctx, cli, author, "post")
+	cli.Use(auditHook())
+	ctx = schema.WithViewer(ctx, schema.Viewer{ID: author.ID})
+
+	if _, err := editBlog(ctx, cli, stale.ID, stale.Version, "edited", stale.Body); err != nil {
+		t.Fatalf("editBlog: %v", err)
+	}
+	// Both the entity read before the edit and its version are stale.
+	_, err := editBlog(ctx, cli, stale.ID, stale.Version, "again", stale.Body)
+	var conflict *ent.VersionConflictError
+	if !errors.As(err, &conflict) {
+		t.Errorf("editBlog at a stale version: got error %v, want a version conflict", err)
+	}
+	err = inTx(ctx, cli, func(cli *ent.Client) error {
+		return cli.Blog.UpdateOne(stale).SetTitle("again").Exec(ctx)
+	})
+	if !errors.As(err, &conflict) {
+		t.Errorf("updating a stale entity: got error %v, want a version conflict", err)
+	}
+	if b := cli.Blog.GetX(ctx, stale.ID); b.Title != "edited" || b.Version != stale.Version+1 {
+		t.Errorf("blog: got %q at version %d, want %q at version %d", b.Title, b.Version, "edited", stale.Version+1)
+	}
+}
+
+func TestBlogPolicy(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := testTenant(t, cli, "t")
+	author, other := testUser(t, ctx, cli, "a@example.com"), testUser(t, ctx, cli, "b@example.com")
+	b := testBlog(t, ctx, cli, author, "post")
+	asOther := schema.WithViewer(ctx, schema.Viewer{ID: other.ID})
+
+	if _, err := editBlog(ctx, cli, b.ID, b.Version, "edited", b.Body); !errors.Is(err, privacy.Deny) {
+		t.Errorf("editing with no viewer: got error %v, want denied", err)
+	}
+	if _, err := editBlog(asOther, cli, b.ID, b.Version, "edited", b.Body); !errors.Is(err, privacy.Deny) {
+		t.Errorf("editing the blog of another user: got error %v, want denied", err)
+	}
+	if err := cli.User.UpdateOneID(other.ID).AddBlogPostIDs(b.ID).Exec(asOther); !errors.Is(err, privacy.Deny) {
+		t.Errorf("taking over the blog of another user: got error %v, want denied", err)
+	}
+	if err := cli.Tag.Create().SetName("go").AddBlogIDs(b.ID).Exec(asOther); !errors.Is(err, privacy.Deny) {
+		t.Errorf("tagging the blog of another user: got error %v, want denied", err)
+	}
+	// Bulk updates are restricted to the blogs of the viewer.
+	if n := cli.Blog.Update().SetBody("edited").SaveX(asOther); n != 0 {
+		t.Errorf("updating all blogs: got %d blogs of another user updated, want none", n)
+	}
+	asAdmin := schema.WithViewer(ctx, schema.Viewer{ID: other.ID, Admin: true})
+	if _, err := editBlog(asAdmin, cli, b.ID, b.Version, "edited", b.Body); err != nil {
+		t.Errorf("editing as an admin: %v", err)
+	}
+}
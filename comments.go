@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/comment"
+
+	"github.com/google/uuid"
+)
+
+// addComment adds a top-level comment to a blog.
+func addComment(ctx context.Context, cli *ent.Client, blogID, authorID uuid.UUID, body string) (*ent.Comment, error) {
+	return inTxEntity(ctx, cli, func(cli *ent.Client) (*ent.Comment, error) {
+		return cli.Comment.Create().
+			SetBlogID(blogID).
+			SetAuthorID(authorID).
+			SetBody(body).
+			Save(ctx)
+	})
+}
+
+// replyToComment adds a reply to a comment, on the blog of that comment.
+func replyToComment(ctx context.Context, cli *ent.Client, parentID int, authorID uuid.UUID, body string) (*ent.Comment, error) {
+	return inTxEntity(ctx, cli, func(cli *ent.Client) (*ent.Comment, error) {
+		parent, err := cli.Comment.Get(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		return cli.Comment.Create().
+			SetBlogID(parent.BlogID).
+			SetParentID(parent.ID).
+			SetAuthorID(authorID).
+			SetBody(body).
+			Save(ctx)
+	})
+}
+
+// threadComment is a comment along with its depth in the thread, top-level
+// comments having a depth of 0.
+type threadComment struct {
+	*ent.Comment
+	Depth int
+}
+
+// commentThread returns the comments of a blog in thread order: every
+// comment is followed by its replies, oldest first, before its next
+// sibling. Comments are loaded in a single query, and none are returned
+// for a deleted blog.
+func commentThread(ctx context.Context, cli *ent.Client, blogID uuid.UUID) ([]threadComment, error) {
+	comments, err := cli.Comment.Query().
+		Where(
+			comment.BlogID(blogID),
+			comment.HasBlogWith(blog.DeletedAtIsNil()),
+		).
+		Order(ent.Asc(comment.FieldCreatedAt), ent.Asc(comment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Comments are grouped by parent, keeping the creation order, and the
+	// groups are then walked depth first from the top-level one.
+	var roots []*ent.Comment
+	replies := make(map[int][]*ent.Comment)
+	for _, c := range comments {
+		if c.ParentID == nil {
+			roots = append(roots, c)
+		} else {
+			replies[*c.ParentID] = append(replies[*c.ParentID], c)
+		}
+	}
+	thread := make([]threadComment, 0, len(comments))
+	var walk func([]*ent.Comment, int)
+	walk = func(cs []*ent.Comment, depth int) {
+		for _, c := range cs {
+			thread = append(thread, threadComment{Comment: c, Depth: depth})
+			walk(replies[c.ID], depth+1)
+		}
+	}
+	walk(roots, 0)
+	return thread, nil
+}
+
+// runComment implements the comment command, commenting on a blog or
+// replying to a comment
+func runComment(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("comment", flag.ExitOnError)
+	s.register(fs)
+	blogID := uuidFlag(fs, "id", "id of the blog to comment on")
+	replyTo := fs.Int("reply-to", 0, "id of the comment to reply to, instead of commenting on a blog")
+	body := fs.String("body", "", "body of the comment")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(withPrimary(ctx), client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	author := requireViewer(ctx, "comment")
+	var c *ent.Comment
+	if *replyTo != 0 {
+		c, err = replyToComment(ctx, client, *replyTo, author, *body)
+	} else {
+		c, err = addComment(ctx, client, *blogID, author, *body)
+	}
+	if err != nil {
+		log.Fatalf("failed commenting: %v", err)
+	}
+	log.Printf("added comment %d", c.ID)
+}
+
+// runComments implements the comments command, printing the comment thread
+// of a blog
+func runComments(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("comments", flag.ExitOnError)
+	s.register(fs)
+	blogID := uuidFlag(fs, "id", "id of the blog")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(ctx, client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	thread, err := commentThread(ctx, client, *blogID)
+	if err != nil {
+		log.Fatalf("failed loading comments: %v", err)
+	}
+	for _, c := range thread {
+		fmt.Printf("%s%d\t%s\n", strings.Repeat("  ", c.Depth), c.ID, c.Body)
+	}
+}
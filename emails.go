@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/schema"
+	"testMigrationEntgo/ent/user"
+)
+
+// emailUser is the id, formatted as text, and the email of a user.
+type emailUser struct {
+	ID    string
+	Email string
+}
+
+// emailReport lists the users whose email prevents the case-insensitive
+// unique index on emails from being created, or is invalid.
+type emailReport struct {
+	// Collisions maps a normalized email to the users sharing it.
+	Collisions map[string][]emailUser
+	Invalid    []emailUser
+}
+
+// checkEmails finds the users, deleted ones included, whose emails only
+// differ by case or are invalid. Emails are unique across tenants, so all
+// of them are checked. It runs before the migrations changing the type of
+// user ids, so it queries the table directly, reading ids as text, rather
+// than through the schema.
+func checkEmails(ctx context.Context, cli *ent.Client) (*emailReport, error) {
+	rows, err := cli.QueryContext(ctx, "SELECT CAST(id AS text), email FROM users ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var users []emailUser
+	for rows.Next() {
+		var u emailUser
+		if err := rows.Scan(&u.ID, &u.Email); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	r := &emailReport{Collisions: make(map[string][]emailUser)}
+	byEmail := make(map[string][]emailUser, len(users))
+	for _, u := range users {
+		email := schema.NormalizeEmail(u.Email)
+		byEmail[email] = append(byEmail[email], u)
+		if user.EmailValidator(email) != nil {
+			r.Invalid = append(r.Invalid, u)
+		}
+	}
+	for email, us := range byEmail {
+		if len(us) > 1 {
+			r.Collisions[email] = us
+		}
+	}
+	return r, nil
+}
+
+func (r *emailReport) String() string {
+	var sb strings.Builder
+	emails := make([]string, 0, len(r.Collisions))
+	for email := range r.Collisions {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+	for _, email := range emails {
+		fmt.Fprintf(&sb, "collision on %s:", email)
+		for _, u := range r.Collisions[email] {
+			fmt.Fprintf(&sb, " %q (id %s)", u.Email, u.ID)
+		}
+		sb.WriteByte('\n')
+	}
+	for _, u := range r.Invalid {
+		fmt.Fprintf(&sb, "invalid email %q (id %s)\n", u.Email, u.ID)
+	}
+	return sb.String()
+}
+
+// runCheckEmails implements the check-emails command, to be run before the
+// add_email_lower_unique migration, which fails on collisions
+func runCheckEmails(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("check-emails", flag.ExitOnError)
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	report, err := checkEmails(ctx, client)
+	if err != nil {
+		log.Fatalf("failed checking emails: %v", err)
+	}
+	fmt.Print(report)
+	if len(report.Collisions) > 0 || len(report.Invalid) > 0 {
+		log.Printf("%d collisions and %d invalid emails to fix by hand", len(report.Collisions), len(report.Invalid))
+		os.Exit(1)
+	}
+	log.Print("no collisions nor invalid emails")
+}
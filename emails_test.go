@@ -0,0 +1,35 @@
+package main
+
+import "testing"
+
+func TestCheckEmails(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := testTenant(t, cli, "t")
+	a := testUser(t, ctx, cli, "a@example.com")
+	b := testUser(t, ctx, cli, "b@example.com")
+	c := testUser(t, ctx, cli, "c@example.com")
+	// Emails are normalized by the schema, so the ones the check is about
+	// predate it.
+	for email, id := range map[string]any{"A@Example.com": b.ID, "not an email": c.ID} {
+		if _, err := cli.ExecContext(ctx, "UPDATE users SET email = ? WHERE id = ?", email, id); err != nil {
+			t.Fatalf("setting email %q: %v", email, err)
+		}
+	}
+
+	report, err := checkEmails(ctx, cli)
+	if err != nil {
+		t.Fatalf("checkEmails: %v", err)
+	}
+	// Users are listed by id, which is random.
+	want := map[string]bool{a.ID.String(): true, b.ID.String(): true}
+	got := report.Collisions["a@example.com"]
+	if len(got) != 2 || !want[got[0].ID] || !want[got[1].ID] || got[0].ID == got[1].ID {
+		t.Errorf("collisions on a@example.com: got %+v, want users %s and %s", got, a.ID, b.ID)
+	}
+	if len(report.Collisions) != 1 {
+		t.Errorf("collisions: got %d, want 1", len(report.Collisions))
+	}
+	if len(report.Invalid) != 1 || report.Invalid[0].ID != c.ID.String() {
+		t.Errorf("invalid emails: got %+v, want user %s", report.Invalid, c.ID)
+	}
+}
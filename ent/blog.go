@@ -6,44 +6,85 @@ import (
 	"fmt"
 	"strings"
 	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/tenant"
 	"testMigrationEntgo/ent/user"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 )
 
 // Blog is the model entity for the Blog schema.
 type Blog struct {
 	config `json:"-"`
 	// ID of the ent.
-	ID int `json:"id,omitempty"`
+	ID uuid.UUID `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
+	// Generated from the title on creation and kept when it changes
+	Slug string `json:"slug,omitempty"`
 	// Body holds the value of the "body" field.
 	Body string `json:"body,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status blog.Status `json:"status,omitempty"`
+	// PublishedAt holds the value of the "published_at" field.
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BlogQuery when eager-loading is set.
 	Edges           BlogEdges `json:"edges"`
-	user_blog_posts *int
+	user_blog_posts *uuid.UUID
 	selectValues    sql.SelectValues
 }
 
 // BlogEdges holds the relations/edges for other nodes in the graph.
 type BlogEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
 	// Author holds the value of the author edge.
 	Author *User `json:"author,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*BlogRevision `json:"revisions,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// Reactions holds the value of the reactions edge.
+	Reactions []*Reaction `json:"reactions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [6]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogEdges) TenantOrErr() (*Tenant, error) {
+	if e.loadedTypes[0] {
+		if e.Tenant == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: tenant.Label}
+		}
+		return e.Tenant, nil
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
 }
 
 // AuthorOrErr returns the Author value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e BlogEdges) AuthorOrErr() (*User, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[1] {
 		if e.Author == nil {
 			// Edge was loaded but was not found.
 			return nil, &NotFoundError{label: user.Label}
@@ -53,19 +94,57 @@ func (e BlogEdges) AuthorOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "author"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogEdges) RevisionsOrErr() ([]*BlogRevision, error) {
+	if e.loadedTypes[2] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[4] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogEdges) ReactionsOrErr() ([]*Reaction, error) {
+	if e.loadedTypes[5] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Blog) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case blog.FieldID:
+		case blog.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case blog.FieldTitle, blog.FieldBody:
+		case blog.FieldTitle, blog.FieldSlug, blog.FieldBody, blog.FieldStatus:
 			values[i] = new(sql.NullString)
-		case blog.FieldCreatedAt:
+		case blog.FieldCreatedAt, blog.FieldUpdatedAt, blog.FieldDeletedAt, blog.FieldPublishedAt, blog.FieldArchivedAt:
 			values[i] = new(sql.NullTime)
+		case blog.FieldID, blog.FieldTenantID:
+			values[i] = new(uuid.UUID)
 		case blog.ForeignKeys[0]: // user_blog_posts
-			values[i] = new(sql.NullInt64)
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -82,35 +161,86 @@ func (b *Blog) assignValues(columns []string, values []any) error {
 	for i := range columns {
 		switch columns[i] {
 		case blog.FieldID:
-			value, ok := values[i].(*sql.NullInt64)
-			if !ok {
-				return fmt.Errorf("unexpected type %T for field id", value)
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				b.ID = *value
+			}
+		case blog.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				b.TenantID = *value
+			}
+		case blog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				b.CreatedAt = value.Time
+			}
+		case blog.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				b.UpdatedAt = value.Time
+			}
+		case blog.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				b.DeletedAt = new(time.Time)
+				*b.DeletedAt = value.Time
+			}
+		case blog.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				b.Version = int(value.Int64)
 			}
-			b.ID = int(value.Int64)
 		case blog.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
 			} else if value.Valid {
 				b.Title = value.String
 			}
+		case blog.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				b.Slug = value.String
+			}
 		case blog.FieldBody:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field body", values[i])
 			} else if value.Valid {
 				b.Body = value.String
 			}
-		case blog.FieldCreatedAt:
+		case blog.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				b.Status = blog.Status(value.String)
+			}
+		case blog.FieldPublishedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
 			} else if value.Valid {
-				b.CreatedAt = value.Time
+				b.PublishedAt = new(time.Time)
+				*b.PublishedAt = value.Time
+			}
+		case blog.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				b.ArchivedAt = new(time.Time)
+				*b.ArchivedAt = value.Time
 			}
 		case blog.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_blog_posts", value)
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_blog_posts", values[i])
 			} else if value.Valid {
-				b.user_blog_posts = new(int)
-				*b.user_blog_posts = int(value.Int64)
+				b.user_blog_posts = new(uuid.UUID)
+				*b.user_blog_posts = *value.S.(*uuid.UUID)
 			}
 		default:
 			b.selectValues.Set(columns[i], values[i])
@@ -125,11 +255,36 @@ func (b *Blog) Value(name string) (ent.Value, error) {
 	return b.selectValues.Get(name)
 }
 
+// QueryTenant queries the "tenant" edge of the Blog entity.
+func (b *Blog) QueryTenant() *TenantQuery {
+	return NewBlogClient(b.config).QueryTenant(b)
+}
+
 // QueryAuthor queries the "author" edge of the Blog entity.
 func (b *Blog) QueryAuthor() *UserQuery {
 	return NewBlogClient(b.config).QueryAuthor(b)
 }
 
+// QueryRevisions queries the "revisions" edge of the Blog entity.
+func (b *Blog) QueryRevisions() *BlogRevisionQuery {
+	return NewBlogClient(b.config).QueryRevisions(b)
+}
+
+// QueryTags queries the "tags" edge of the Blog entity.
+func (b *Blog) QueryTags() *TagQuery {
+	return NewBlogClient(b.config).QueryTags(b)
+}
+
+// QueryComments queries the "comments" edge of the Blog entity.
+func (b *Blog) QueryComments() *CommentQuery {
+	return NewBlogClient(b.config).QueryComments(b)
+}
+
+// QueryReactions queries the "reactions" edge of the Blog entity.
+func (b *Blog) QueryReactions() *ReactionQuery {
+	return NewBlogClient(b.config).QueryReactions(b)
+}
+
 // Update returns a builder for updating this Blog.
 // Note that you need to call Blog.Unwrap() before calling this method if this Blog
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -153,14 +308,44 @@ func (b *Blog) String() string {
 	var builder strings.Builder
 	builder.WriteString("Blog(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", b.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(b.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := b.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", b.Version))
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(b.Title)
 	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(b.Slug)
+	builder.WriteString(", ")
 	builder.WriteString("body=")
 	builder.WriteString(b.Body)
 	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", b.Status))
+	builder.WriteString(", ")
+	if v := b.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := b.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
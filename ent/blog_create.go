@@ -7,11 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/comment"
+	"testMigrationEntgo/ent/reaction"
+	"testMigrationEntgo/ent/tag"
+	"testMigrationEntgo/ent/tenant"
 	"testMigrationEntgo/ent/user"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // BlogCreate is the builder for creating a Blog entity.
@@ -19,6 +27,69 @@ type BlogCreate struct {
 	config
 	mutation *BlogMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (bc *BlogCreate) SetTenantID(u uuid.UUID) *BlogCreate {
+	bc.mutation.SetTenantID(u)
+	return bc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bc *BlogCreate) SetCreatedAt(t time.Time) *BlogCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bc *BlogCreate) SetNillableCreatedAt(t *time.Time) *BlogCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bc *BlogCreate) SetUpdatedAt(t time.Time) *BlogCreate {
+	bc.mutation.SetUpdatedAt(t)
+	return bc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bc *BlogCreate) SetNillableUpdatedAt(t *time.Time) *BlogCreate {
+	if t != nil {
+		bc.SetUpdatedAt(*t)
+	}
+	return bc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (bc *BlogCreate) SetDeletedAt(t time.Time) *BlogCreate {
+	bc.mutation.SetDeletedAt(t)
+	return bc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (bc *BlogCreate) SetNillableDeletedAt(t *time.Time) *BlogCreate {
+	if t != nil {
+		bc.SetDeletedAt(*t)
+	}
+	return bc
+}
+
+// SetVersion sets the "version" field.
+func (bc *BlogCreate) SetVersion(i int) *BlogCreate {
+	bc.mutation.SetVersion(i)
+	return bc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (bc *BlogCreate) SetNillableVersion(i *int) *BlogCreate {
+	if i != nil {
+		bc.SetVersion(*i)
+	}
+	return bc
 }
 
 // SetTitle sets the "title" field.
@@ -27,34 +98,87 @@ func (bc *BlogCreate) SetTitle(s string) *BlogCreate {
 	return bc
 }
 
+// SetSlug sets the "slug" field.
+func (bc *BlogCreate) SetSlug(s string) *BlogCreate {
+	bc.mutation.SetSlug(s)
+	return bc
+}
+
 // SetBody sets the "body" field.
 func (bc *BlogCreate) SetBody(s string) *BlogCreate {
 	bc.mutation.SetBody(s)
 	return bc
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (bc *BlogCreate) SetCreatedAt(t time.Time) *BlogCreate {
-	bc.mutation.SetCreatedAt(t)
+// SetStatus sets the "status" field.
+func (bc *BlogCreate) SetStatus(b blog.Status) *BlogCreate {
+	bc.mutation.SetStatus(b)
 	return bc
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (bc *BlogCreate) SetNillableCreatedAt(t *time.Time) *BlogCreate {
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bc *BlogCreate) SetNillableStatus(b *blog.Status) *BlogCreate {
+	if b != nil {
+		bc.SetStatus(*b)
+	}
+	return bc
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (bc *BlogCreate) SetPublishedAt(t time.Time) *BlogCreate {
+	bc.mutation.SetPublishedAt(t)
+	return bc
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (bc *BlogCreate) SetNillablePublishedAt(t *time.Time) *BlogCreate {
 	if t != nil {
-		bc.SetCreatedAt(*t)
+		bc.SetPublishedAt(*t)
+	}
+	return bc
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (bc *BlogCreate) SetArchivedAt(t time.Time) *BlogCreate {
+	bc.mutation.SetArchivedAt(t)
+	return bc
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (bc *BlogCreate) SetNillableArchivedAt(t *time.Time) *BlogCreate {
+	if t != nil {
+		bc.SetArchivedAt(*t)
 	}
 	return bc
 }
 
+// SetID sets the "id" field.
+func (bc *BlogCreate) SetID(u uuid.UUID) *BlogCreate {
+	bc.mutation.SetID(u)
+	return bc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (bc *BlogCreate) SetNillableID(u *uuid.UUID) *BlogCreate {
+	if u != nil {
+		bc.SetID(*u)
+	}
+	return bc
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (bc *BlogCreate) SetTenant(t *Tenant) *BlogCreate {
+	return bc.SetTenantID(t.ID)
+}
+
 // SetAuthorID sets the "author" edge to the User entity by ID.
-func (bc *BlogCreate) SetAuthorID(id int) *BlogCreate {
+func (bc *BlogCreate) SetAuthorID(id uuid.UUID) *BlogCreate {
 	bc.mutation.SetAuthorID(id)
 	return bc
 }
 
 // SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
-func (bc *BlogCreate) SetNillableAuthorID(id *int) *BlogCreate {
+func (bc *BlogCreate) SetNillableAuthorID(id *uuid.UUID) *BlogCreate {
 	if id != nil {
 		bc = bc.SetAuthorID(*id)
 	}
@@ -66,6 +190,66 @@ func (bc *BlogCreate) SetAuthor(u *User) *BlogCreate {
 	return bc.SetAuthorID(u.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the BlogRevision entity by IDs.
+func (bc *BlogCreate) AddRevisionIDs(ids ...int) *BlogCreate {
+	bc.mutation.AddRevisionIDs(ids...)
+	return bc
+}
+
+// AddRevisions adds the "revisions" edges to the BlogRevision entity.
+func (bc *BlogCreate) AddRevisions(b ...*BlogRevision) *BlogCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bc.AddRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (bc *BlogCreate) AddTagIDs(ids ...int) *BlogCreate {
+	bc.mutation.AddTagIDs(ids...)
+	return bc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (bc *BlogCreate) AddTags(t ...*Tag) *BlogCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bc.AddTagIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (bc *BlogCreate) AddCommentIDs(ids ...int) *BlogCreate {
+	bc.mutation.AddCommentIDs(ids...)
+	return bc
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (bc *BlogCreate) AddComments(c ...*Comment) *BlogCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return bc.AddCommentIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by IDs.
+func (bc *BlogCreate) AddReactionIDs(ids ...int) *BlogCreate {
+	bc.mutation.AddReactionIDs(ids...)
+	return bc
+}
+
+// AddReactions adds the "reactions" edges to the Reaction entity.
+func (bc *BlogCreate) AddReactions(r ...*Reaction) *BlogCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bc.AddReactionIDs(ids...)
+}
+
 // Mutation returns the BlogMutation object of the builder.
 func (bc *BlogCreate) Mutation() *BlogMutation {
 	return bc.mutation
@@ -73,7 +257,9 @@ func (bc *BlogCreate) Mutation() *BlogMutation {
 
 // Save creates the Blog in the database.
 func (bc *BlogCreate) Save(ctx context.Context) (*Blog, error) {
-	bc.defaults()
+	if err := bc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
 }
 
@@ -100,23 +286,82 @@ func (bc *BlogCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (bc *BlogCreate) defaults() {
+func (bc *BlogCreate) defaults() error {
 	if _, ok := bc.mutation.CreatedAt(); !ok {
+		if blog.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized blog.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := blog.DefaultCreatedAt()
 		bc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		if blog.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized blog.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := blog.DefaultUpdatedAt()
+		bc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := bc.mutation.Version(); !ok {
+		v := blog.DefaultVersion
+		bc.mutation.SetVersion(v)
+	}
+	if _, ok := bc.mutation.Status(); !ok {
+		v := blog.DefaultStatus
+		bc.mutation.SetStatus(v)
+	}
+	if _, ok := bc.mutation.ID(); !ok {
+		if blog.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized blog.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := blog.DefaultID()
+		bc.mutation.SetID(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (bc *BlogCreate) check() error {
+	if _, ok := bc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Blog.tenant_id"`)}
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Blog.created_at"`)}
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Blog.updated_at"`)}
+	}
+	if _, ok := bc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Blog.version"`)}
+	}
+	if v, ok := bc.mutation.Version(); ok {
+		if err := blog.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Blog.version": %w`, err)}
+		}
+	}
 	if _, ok := bc.mutation.Title(); !ok {
 		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Blog.title"`)}
 	}
+	if _, ok := bc.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "Blog.slug"`)}
+	}
+	if v, ok := bc.mutation.Slug(); ok {
+		if err := blog.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Blog.slug": %w`, err)}
+		}
+	}
 	if _, ok := bc.mutation.Body(); !ok {
 		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "Blog.body"`)}
 	}
-	if _, ok := bc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Blog.created_at"`)}
+	if _, ok := bc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Blog.status"`)}
+	}
+	if v, ok := bc.mutation.Status(); ok {
+		if err := blog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Blog.status": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "Blog.tenant"`)}
 	}
 	return nil
 }
@@ -132,8 +377,13 @@ func (bc *BlogCreate) sqlSave(ctx context.Context) (*Blog, error) {
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
 	bc.mutation.id = &_node.ID
 	bc.mutation.done = true
 	return _node, nil
@@ -142,19 +392,69 @@ func (bc *BlogCreate) sqlSave(ctx context.Context) (*Blog, error) {
 func (bc *BlogCreate) createSpec() (*Blog, *sqlgraph.CreateSpec) {
 	var (
 		_node = &Blog{config: bc.config}
-		_spec = sqlgraph.NewCreateSpec(blog.Table, sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt))
+		_spec = sqlgraph.NewCreateSpec(blog.Table, sqlgraph.NewFieldSpec(blog.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = bc.conflict
+	if id, ok := bc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.SetField(blog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bc.mutation.UpdatedAt(); ok {
+		_spec.SetField(blog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := bc.mutation.DeletedAt(); ok {
+		_spec.SetField(blog.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := bc.mutation.Version(); ok {
+		_spec.SetField(blog.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := bc.mutation.Title(); ok {
 		_spec.SetField(blog.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
+	if value, ok := bc.mutation.Slug(); ok {
+		_spec.SetField(blog.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
 	if value, ok := bc.mutation.Body(); ok {
 		_spec.SetField(blog.FieldBody, field.TypeString, value)
 		_node.Body = value
 	}
-	if value, ok := bc.mutation.CreatedAt(); ok {
-		_spec.SetField(blog.FieldCreatedAt, field.TypeTime, value)
-		_node.CreatedAt = value
+	if value, ok := bc.mutation.Status(); ok {
+		_spec.SetField(blog.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := bc.mutation.PublishedAt(); ok {
+		_spec.SetField(blog.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if value, ok := bc.mutation.ArchivedAt(); ok {
+		_spec.SetField(blog.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if nodes := bc.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   blog.TenantTable,
+			Columns: []string{blog.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := bc.mutation.AuthorIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
@@ -164,7 +464,7 @@ func (bc *BlogCreate) createSpec() (*Blog, *sqlgraph.CreateSpec) {
 			Columns: []string{blog.AuthorColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -173,14 +473,506 @@ func (bc *BlogCreate) createSpec() (*Blog, *sqlgraph.CreateSpec) {
 		_node.user_blog_posts = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := bc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.RevisionsTable,
+			Columns: []string{blog.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.CommentsTable,
+			Columns: []string{blog.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ReactionsTable,
+			Columns: []string{blog.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Blog.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlogUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (bc *BlogCreate) OnConflict(opts ...sql.ConflictOption) *BlogUpsertOne {
+	bc.conflict = opts
+	return &BlogUpsertOne{
+		create: bc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Blog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bc *BlogCreate) OnConflictColumns(columns ...string) *BlogUpsertOne {
+	bc.conflict = append(bc.conflict, sql.ConflictColumns(columns...))
+	return &BlogUpsertOne{
+		create: bc,
+	}
+}
+
+type (
+	// BlogUpsertOne is the builder for "upsert"-ing
+	//  one Blog node.
+	BlogUpsertOne struct {
+		create *BlogCreate
+	}
+
+	// BlogUpsert is the "OnConflict" setter.
+	BlogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlogUpsert) SetUpdatedAt(v time.Time) *BlogUpsert {
+	u.Set(blog.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlogUpsert) UpdateUpdatedAt() *BlogUpsert {
+	u.SetExcluded(blog.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *BlogUpsert) SetDeletedAt(v time.Time) *BlogUpsert {
+	u.Set(blog.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *BlogUpsert) UpdateDeletedAt() *BlogUpsert {
+	u.SetExcluded(blog.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *BlogUpsert) ClearDeletedAt() *BlogUpsert {
+	u.SetNull(blog.FieldDeletedAt)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *BlogUpsert) SetVersion(v int) *BlogUpsert {
+	u.Set(blog.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *BlogUpsert) UpdateVersion() *BlogUpsert {
+	u.SetExcluded(blog.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *BlogUpsert) AddVersion(v int) *BlogUpsert {
+	u.Add(blog.FieldVersion, v)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *BlogUpsert) SetTitle(v string) *BlogUpsert {
+	u.Set(blog.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *BlogUpsert) UpdateTitle() *BlogUpsert {
+	u.SetExcluded(blog.FieldTitle)
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *BlogUpsert) SetSlug(v string) *BlogUpsert {
+	u.Set(blog.FieldSlug, v)
+	return u
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *BlogUpsert) UpdateSlug() *BlogUpsert {
+	u.SetExcluded(blog.FieldSlug)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *BlogUpsert) SetBody(v string) *BlogUpsert {
+	u.Set(blog.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *BlogUpsert) UpdateBody() *BlogUpsert {
+	u.SetExcluded(blog.FieldBody)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *BlogUpsert) SetStatus(v blog.Status) *BlogUpsert {
+	u.Set(blog.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BlogUpsert) UpdateStatus() *BlogUpsert {
+	u.SetExcluded(blog.FieldStatus)
+	return u
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *BlogUpsert) SetPublishedAt(v time.Time) *BlogUpsert {
+	u.Set(blog.FieldPublishedAt, v)
+	return u
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *BlogUpsert) UpdatePublishedAt() *BlogUpsert {
+	u.SetExcluded(blog.FieldPublishedAt)
+	return u
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *BlogUpsert) ClearPublishedAt() *BlogUpsert {
+	u.SetNull(blog.FieldPublishedAt)
+	return u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *BlogUpsert) SetArchivedAt(v time.Time) *BlogUpsert {
+	u.Set(blog.FieldArchivedAt, v)
+	return u
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *BlogUpsert) UpdateArchivedAt() *BlogUpsert {
+	u.SetExcluded(blog.FieldArchivedAt)
+	return u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *BlogUpsert) ClearArchivedAt() *BlogUpsert {
+	u.SetNull(blog.FieldArchivedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Blog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(blog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BlogUpsertOne) UpdateNewValues() *BlogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(blog.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(blog.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(blog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Blog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BlogUpsertOne) Ignore() *BlogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlogUpsertOne) DoNothing() *BlogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlogCreate.OnConflict
+// documentation for more info.
+func (u *BlogUpsertOne) Update(set func(*BlogUpsert)) *BlogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlogUpsertOne) SetUpdatedAt(v time.Time) *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlogUpsertOne) UpdateUpdatedAt() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *BlogUpsertOne) SetDeletedAt(v time.Time) *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *BlogUpsertOne) UpdateDeletedAt() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *BlogUpsertOne) ClearDeletedAt() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *BlogUpsertOne) SetVersion(v int) *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *BlogUpsertOne) AddVersion(v int) *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *BlogUpsertOne) UpdateVersion() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *BlogUpsertOne) SetTitle(v string) *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *BlogUpsertOne) UpdateTitle() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *BlogUpsertOne) SetSlug(v string) *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *BlogUpsertOne) UpdateSlug() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *BlogUpsertOne) SetBody(v string) *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *BlogUpsertOne) UpdateBody() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BlogUpsertOne) SetStatus(v blog.Status) *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BlogUpsertOne) UpdateStatus() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *BlogUpsertOne) SetPublishedAt(v time.Time) *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *BlogUpsertOne) UpdatePublishedAt() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *BlogUpsertOne) ClearPublishedAt() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *BlogUpsertOne) SetArchivedAt(v time.Time) *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *BlogUpsertOne) UpdateArchivedAt() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *BlogUpsertOne) ClearArchivedAt() *BlogUpsertOne {
+	return u.Update(func(s *BlogUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BlogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BlogUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BlogUpsertOne.ID is not supported by MySQL driver. Use BlogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BlogUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // BlogCreateBulk is the builder for creating many Blog entities in bulk.
 type BlogCreateBulk struct {
 	config
 	err      error
 	builders []*BlogCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Blog entities in the database.
@@ -210,6 +1002,7 @@ func (bcb *BlogCreateBulk) Save(ctx context.Context) ([]*Blog, error) {
 					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -221,10 +1014,6 @@ func (bcb *BlogCreateBulk) Save(ctx context.Context) ([]*Blog, error) {
 					return nil, err
 				}
 				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
 				mutation.done = true
 				return nodes[i], nil
 			})
@@ -263,3 +1052,277 @@ func (bcb *BlogCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Blog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlogUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (bcb *BlogCreateBulk) OnConflict(opts ...sql.ConflictOption) *BlogUpsertBulk {
+	bcb.conflict = opts
+	return &BlogUpsertBulk{
+		create: bcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Blog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bcb *BlogCreateBulk) OnConflictColumns(columns ...string) *BlogUpsertBulk {
+	bcb.conflict = append(bcb.conflict, sql.ConflictColumns(columns...))
+	return &BlogUpsertBulk{
+		create: bcb,
+	}
+}
+
+// BlogUpsertBulk is the builder for "upsert"-ing
+// a bulk of Blog nodes.
+type BlogUpsertBulk struct {
+	create *BlogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Blog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(blog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BlogUpsertBulk) UpdateNewValues() *BlogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(blog.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(blog.FieldTenantID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(blog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Blog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BlogUpsertBulk) Ignore() *BlogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlogUpsertBulk) DoNothing() *BlogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlogCreateBulk.OnConflict
+// documentation for more info.
+func (u *BlogUpsertBulk) Update(set func(*BlogUpsert)) *BlogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlogUpsertBulk) SetUpdatedAt(v time.Time) *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlogUpsertBulk) UpdateUpdatedAt() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *BlogUpsertBulk) SetDeletedAt(v time.Time) *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *BlogUpsertBulk) UpdateDeletedAt() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *BlogUpsertBulk) ClearDeletedAt() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *BlogUpsertBulk) SetVersion(v int) *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *BlogUpsertBulk) AddVersion(v int) *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *BlogUpsertBulk) UpdateVersion() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *BlogUpsertBulk) SetTitle(v string) *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *BlogUpsertBulk) UpdateTitle() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *BlogUpsertBulk) SetSlug(v string) *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *BlogUpsertBulk) UpdateSlug() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *BlogUpsertBulk) SetBody(v string) *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *BlogUpsertBulk) UpdateBody() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BlogUpsertBulk) SetStatus(v blog.Status) *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BlogUpsertBulk) UpdateStatus() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *BlogUpsertBulk) SetPublishedAt(v time.Time) *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *BlogUpsertBulk) UpdatePublishedAt() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *BlogUpsertBulk) ClearPublishedAt() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *BlogUpsertBulk) SetArchivedAt(v time.Time) *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *BlogUpsertBulk) UpdateArchivedAt() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *BlogUpsertBulk) ClearArchivedAt() *BlogUpsertBulk {
+	return u.Update(func(s *BlogUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BlogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BlogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
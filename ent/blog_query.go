@@ -4,26 +4,40 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/comment"
 	"testMigrationEntgo/ent/predicate"
+	"testMigrationEntgo/ent/reaction"
+	"testMigrationEntgo/ent/tag"
+	"testMigrationEntgo/ent/tenant"
 	"testMigrationEntgo/ent/user"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // BlogQuery is the builder for querying Blog entities.
 type BlogQuery struct {
 	config
-	ctx        *QueryContext
-	order      []blog.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Blog
-	withAuthor *UserQuery
-	withFKs    bool
+	ctx           *QueryContext
+	order         []blog.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Blog
+	withTenant    *TenantQuery
+	withAuthor    *UserQuery
+	withRevisions *BlogRevisionQuery
+	withTags      *TagQuery
+	withComments  *CommentQuery
+	withReactions *ReactionQuery
+	withFKs       bool
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,6 +74,28 @@ func (bq *BlogQuery) Order(o ...blog.OrderOption) *BlogQuery {
 	return bq
 }
 
+// QueryTenant chains the current query on the "tenant" edge.
+func (bq *BlogQuery) QueryTenant() *TenantQuery {
+	query := (&TenantClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blog.Table, blog.FieldID, selector),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, blog.TenantTable, blog.TenantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryAuthor chains the current query on the "author" edge.
 func (bq *BlogQuery) QueryAuthor() *UserQuery {
 	query := (&UserClient{config: bq.config}).Query()
@@ -82,6 +118,94 @@ func (bq *BlogQuery) QueryAuthor() *UserQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (bq *BlogQuery) QueryRevisions() *BlogRevisionQuery {
+	query := (&BlogRevisionClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blog.Table, blog.FieldID, selector),
+			sqlgraph.To(blogrevision.Table, blogrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, blog.RevisionsTable, blog.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (bq *BlogQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blog.Table, blog.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, blog.TagsTable, blog.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryComments chains the current query on the "comments" edge.
+func (bq *BlogQuery) QueryComments() *CommentQuery {
+	query := (&CommentClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blog.Table, blog.FieldID, selector),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, blog.CommentsTable, blog.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReactions chains the current query on the "reactions" edge.
+func (bq *BlogQuery) QueryReactions() *ReactionQuery {
+	query := (&ReactionClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blog.Table, blog.FieldID, selector),
+			sqlgraph.To(reaction.Table, reaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, blog.ReactionsTable, blog.ReactionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Blog entity from the query.
 // Returns a *NotFoundError when no Blog was found.
 func (bq *BlogQuery) First(ctx context.Context) (*Blog, error) {
@@ -106,8 +230,8 @@ func (bq *BlogQuery) FirstX(ctx context.Context) *Blog {
 
 // FirstID returns the first Blog ID from the query.
 // Returns a *NotFoundError when no Blog ID was found.
-func (bq *BlogQuery) FirstID(ctx context.Context) (id int, err error) {
-	var ids []int
+func (bq *BlogQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
 	if ids, err = bq.Limit(1).IDs(setContextOp(ctx, bq.ctx, "FirstID")); err != nil {
 		return
 	}
@@ -119,7 +243,7 @@ func (bq *BlogQuery) FirstID(ctx context.Context) (id int, err error) {
 }
 
 // FirstIDX is like FirstID, but panics if an error occurs.
-func (bq *BlogQuery) FirstIDX(ctx context.Context) int {
+func (bq *BlogQuery) FirstIDX(ctx context.Context) uuid.UUID {
 	id, err := bq.FirstID(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
@@ -157,8 +281,8 @@ func (bq *BlogQuery) OnlyX(ctx context.Context) *Blog {
 // OnlyID is like Only, but returns the only Blog ID in the query.
 // Returns a *NotSingularError when more than one Blog ID is found.
 // Returns a *NotFoundError when no entities are found.
-func (bq *BlogQuery) OnlyID(ctx context.Context) (id int, err error) {
-	var ids []int
+func (bq *BlogQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
 	if ids, err = bq.Limit(2).IDs(setContextOp(ctx, bq.ctx, "OnlyID")); err != nil {
 		return
 	}
@@ -174,7 +298,7 @@ func (bq *BlogQuery) OnlyID(ctx context.Context) (id int, err error) {
 }
 
 // OnlyIDX is like OnlyID, but panics if an error occurs.
-func (bq *BlogQuery) OnlyIDX(ctx context.Context) int {
+func (bq *BlogQuery) OnlyIDX(ctx context.Context) uuid.UUID {
 	id, err := bq.OnlyID(ctx)
 	if err != nil {
 		panic(err)
@@ -202,7 +326,7 @@ func (bq *BlogQuery) AllX(ctx context.Context) []*Blog {
 }
 
 // IDs executes the query and returns a list of Blog IDs.
-func (bq *BlogQuery) IDs(ctx context.Context) (ids []int, err error) {
+func (bq *BlogQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
 	if bq.ctx.Unique == nil && bq.path != nil {
 		bq.Unique(true)
 	}
@@ -214,7 +338,7 @@ func (bq *BlogQuery) IDs(ctx context.Context) (ids []int, err error) {
 }
 
 // IDsX is like IDs, but panics if an error occurs.
-func (bq *BlogQuery) IDsX(ctx context.Context) []int {
+func (bq *BlogQuery) IDsX(ctx context.Context) []uuid.UUID {
 	ids, err := bq.IDs(ctx)
 	if err != nil {
 		panic(err)
@@ -269,18 +393,34 @@ func (bq *BlogQuery) Clone() *BlogQuery {
 		return nil
 	}
 	return &BlogQuery{
-		config:     bq.config,
-		ctx:        bq.ctx.Clone(),
-		order:      append([]blog.OrderOption{}, bq.order...),
-		inters:     append([]Interceptor{}, bq.inters...),
-		predicates: append([]predicate.Blog{}, bq.predicates...),
-		withAuthor: bq.withAuthor.Clone(),
+		config:        bq.config,
+		ctx:           bq.ctx.Clone(),
+		order:         append([]blog.OrderOption{}, bq.order...),
+		inters:        append([]Interceptor{}, bq.inters...),
+		predicates:    append([]predicate.Blog{}, bq.predicates...),
+		withTenant:    bq.withTenant.Clone(),
+		withAuthor:    bq.withAuthor.Clone(),
+		withRevisions: bq.withRevisions.Clone(),
+		withTags:      bq.withTags.Clone(),
+		withComments:  bq.withComments.Clone(),
+		withReactions: bq.withReactions.Clone(),
 		// clone intermediate query.
 		sql:  bq.sql.Clone(),
 		path: bq.path,
 	}
 }
 
+// WithTenant tells the query-builder to eager-load the nodes that are connected to
+// the "tenant" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BlogQuery) WithTenant(opts ...func(*TenantQuery)) *BlogQuery {
+	query := (&TenantClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withTenant = query
+	return bq
+}
+
 // WithAuthor tells the query-builder to eager-load the nodes that are connected to
 // the "author" edge. The optional arguments are used to configure the query builder of the edge.
 func (bq *BlogQuery) WithAuthor(opts ...func(*UserQuery)) *BlogQuery {
@@ -292,18 +432,62 @@ func (bq *BlogQuery) WithAuthor(opts ...func(*UserQuery)) *BlogQuery {
 	return bq
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BlogQuery) WithRevisions(opts ...func(*BlogRevisionQuery)) *BlogQuery {
+	query := (&BlogRevisionClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withRevisions = query
+	return bq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BlogQuery) WithTags(opts ...func(*TagQuery)) *BlogQuery {
+	query := (&TagClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withTags = query
+	return bq
+}
+
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BlogQuery) WithComments(opts ...func(*CommentQuery)) *BlogQuery {
+	query := (&CommentClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withComments = query
+	return bq
+}
+
+// WithReactions tells the query-builder to eager-load the nodes that are connected to
+// the "reactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BlogQuery) WithReactions(opts ...func(*ReactionQuery)) *BlogQuery {
+	query := (&ReactionClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withReactions = query
+	return bq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		TenantID uuid.UUID `json:"tenant_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Blog.Query().
-//		GroupBy(blog.FieldTitle).
+//		GroupBy(blog.FieldTenantID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (bq *BlogQuery) GroupBy(field string, fields ...string) *BlogGroupBy {
@@ -321,11 +505,11 @@ func (bq *BlogQuery) GroupBy(field string, fields ...string) *BlogGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		TenantID uuid.UUID `json:"tenant_id,omitempty"`
 //	}
 //
 //	client.Blog.Query().
-//		Select(blog.FieldTitle).
+//		Select(blog.FieldTenantID).
 //		Scan(ctx, &v)
 func (bq *BlogQuery) Select(fields ...string) *BlogSelect {
 	bq.ctx.Fields = append(bq.ctx.Fields, fields...)
@@ -363,6 +547,12 @@ func (bq *BlogQuery) prepareQuery(ctx context.Context) error {
 		}
 		bq.sql = prev
 	}
+	if blog.Policy == nil {
+		return errors.New("ent: uninitialized blog.Policy (forgotten import ent/runtime?)")
+	}
+	if err := blog.Policy.EvalQuery(ctx, bq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -371,8 +561,13 @@ func (bq *BlogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blog, e
 		nodes       = []*Blog{}
 		withFKs     = bq.withFKs
 		_spec       = bq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [6]bool{
+			bq.withTenant != nil,
 			bq.withAuthor != nil,
+			bq.withRevisions != nil,
+			bq.withTags != nil,
+			bq.withComments != nil,
+			bq.withReactions != nil,
 		}
 	)
 	if bq.withAuthor != nil {
@@ -390,6 +585,9 @@ func (bq *BlogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blog, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -399,18 +597,81 @@ func (bq *BlogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blog, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := bq.withTenant; query != nil {
+		if err := bq.loadTenant(ctx, query, nodes, nil,
+			func(n *Blog, e *Tenant) { n.Edges.Tenant = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := bq.withAuthor; query != nil {
 		if err := bq.loadAuthor(ctx, query, nodes, nil,
 			func(n *Blog, e *User) { n.Edges.Author = e }); err != nil {
 			return nil, err
 		}
 	}
+	if query := bq.withRevisions; query != nil {
+		if err := bq.loadRevisions(ctx, query, nodes,
+			func(n *Blog) { n.Edges.Revisions = []*BlogRevision{} },
+			func(n *Blog, e *BlogRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withTags; query != nil {
+		if err := bq.loadTags(ctx, query, nodes,
+			func(n *Blog) { n.Edges.Tags = []*Tag{} },
+			func(n *Blog, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withComments; query != nil {
+		if err := bq.loadComments(ctx, query, nodes,
+			func(n *Blog) { n.Edges.Comments = []*Comment{} },
+			func(n *Blog, e *Comment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withReactions; query != nil {
+		if err := bq.loadReactions(ctx, query, nodes,
+			func(n *Blog) { n.Edges.Reactions = []*Reaction{} },
+			func(n *Blog, e *Reaction) { n.Edges.Reactions = append(n.Edges.Reactions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (bq *BlogQuery) loadTenant(ctx context.Context, query *TenantQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *Tenant)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Blog)
+	for i := range nodes {
+		fk := nodes[i].TenantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tenant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tenant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (bq *BlogQuery) loadAuthor(ctx context.Context, query *UserQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *User)) error {
-	ids := make([]int, 0, len(nodes))
-	nodeids := make(map[int][]*Blog)
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Blog)
 	for i := range nodes {
 		if nodes[i].user_blog_posts == nil {
 			continue
@@ -440,9 +701,163 @@ func (bq *BlogQuery) loadAuthor(ctx context.Context, query *UserQuery, nodes []*
 	}
 	return nil
 }
+func (bq *BlogQuery) loadRevisions(ctx context.Context, query *BlogRevisionQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *BlogRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Blog)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(blogrevision.FieldBlogID)
+	}
+	query.Where(predicate.BlogRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(blog.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BlogID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blog_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (bq *BlogQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Blog)
+	nids := make(map[int]map[*Blog]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(blog.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(blog.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(blog.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(blog.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Blog]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (bq *BlogQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *Comment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Blog)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(comment.FieldBlogID)
+	}
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(blog.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BlogID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blog_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (bq *BlogQuery) loadReactions(ctx context.Context, query *ReactionQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *Reaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Blog)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reaction.FieldBlogID)
+	}
+	query.Where(predicate.Reaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(blog.ReactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BlogID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blog_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (bq *BlogQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := bq.querySpec()
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
 	_spec.Node.Columns = bq.ctx.Fields
 	if len(bq.ctx.Fields) > 0 {
 		_spec.Unique = bq.ctx.Unique != nil && *bq.ctx.Unique
@@ -451,7 +866,7 @@ func (bq *BlogQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (bq *BlogQuery) querySpec() *sqlgraph.QuerySpec {
-	_spec := sqlgraph.NewQuerySpec(blog.Table, blog.Columns, sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewQuerySpec(blog.Table, blog.Columns, sqlgraph.NewFieldSpec(blog.FieldID, field.TypeUUID))
 	_spec.From = bq.sql
 	if unique := bq.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
@@ -466,6 +881,9 @@ func (bq *BlogQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if bq.withTenant != nil {
+			_spec.Node.AddColumnOnce(blog.FieldTenantID)
+		}
 	}
 	if ps := bq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -505,6 +923,9 @@ func (bq *BlogQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if bq.ctx.Unique != nil && *bq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range bq.modifiers {
+		m(selector)
+	}
 	for _, p := range bq.predicates {
 		p(selector)
 	}
@@ -522,6 +943,12 @@ func (bq *BlogQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bq *BlogQuery) Modify(modifiers ...func(s *sql.Selector)) *BlogSelect {
+	bq.modifiers = append(bq.modifiers, modifiers...)
+	return bq.Select()
+}
+
 // BlogGroupBy is the group-by builder for Blog entities.
 type BlogGroupBy struct {
 	selector
@@ -611,3 +1038,9 @@ func (bs *BlogSelect) sqlScan(ctx context.Context, root *BlogQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bs *BlogSelect) Modify(modifiers ...func(s *sql.Selector)) *BlogSelect {
+	bs.modifiers = append(bs.modifiers, modifiers...)
+	return bs
+}
@@ -7,20 +7,26 @@ import (
 	"errors"
 	"fmt"
 	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/comment"
 	"testMigrationEntgo/ent/predicate"
+	"testMigrationEntgo/ent/reaction"
+	"testMigrationEntgo/ent/tag"
 	"testMigrationEntgo/ent/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // BlogUpdate is the builder for updating Blog entities.
 type BlogUpdate struct {
 	config
-	hooks    []Hook
-	mutation *BlogMutation
+	hooks     []Hook
+	mutation  *BlogMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the BlogUpdate builder.
@@ -29,6 +35,61 @@ func (bu *BlogUpdate) Where(ps ...predicate.Blog) *BlogUpdate {
 	return bu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (bu *BlogUpdate) SetUpdatedAt(t time.Time) *BlogUpdate {
+	bu.mutation.SetUpdatedAt(t)
+	return bu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bu *BlogUpdate) SetNillableUpdatedAt(t *time.Time) *BlogUpdate {
+	if t != nil {
+		bu.SetUpdatedAt(*t)
+	}
+	return bu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (bu *BlogUpdate) SetDeletedAt(t time.Time) *BlogUpdate {
+	bu.mutation.SetDeletedAt(t)
+	return bu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (bu *BlogUpdate) SetNillableDeletedAt(t *time.Time) *BlogUpdate {
+	if t != nil {
+		bu.SetDeletedAt(*t)
+	}
+	return bu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (bu *BlogUpdate) ClearDeletedAt() *BlogUpdate {
+	bu.mutation.ClearDeletedAt()
+	return bu
+}
+
+// SetVersion sets the "version" field.
+func (bu *BlogUpdate) SetVersion(i int) *BlogUpdate {
+	bu.mutation.ResetVersion()
+	bu.mutation.SetVersion(i)
+	return bu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (bu *BlogUpdate) SetNillableVersion(i *int) *BlogUpdate {
+	if i != nil {
+		bu.SetVersion(*i)
+	}
+	return bu
+}
+
+// AddVersion adds i to the "version" field.
+func (bu *BlogUpdate) AddVersion(i int) *BlogUpdate {
+	bu.mutation.AddVersion(i)
+	return bu
+}
+
 // SetTitle sets the "title" field.
 func (bu *BlogUpdate) SetTitle(s string) *BlogUpdate {
 	bu.mutation.SetTitle(s)
@@ -43,6 +104,20 @@ func (bu *BlogUpdate) SetNillableTitle(s *string) *BlogUpdate {
 	return bu
 }
 
+// SetSlug sets the "slug" field.
+func (bu *BlogUpdate) SetSlug(s string) *BlogUpdate {
+	bu.mutation.SetSlug(s)
+	return bu
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (bu *BlogUpdate) SetNillableSlug(s *string) *BlogUpdate {
+	if s != nil {
+		bu.SetSlug(*s)
+	}
+	return bu
+}
+
 // SetBody sets the "body" field.
 func (bu *BlogUpdate) SetBody(s string) *BlogUpdate {
 	bu.mutation.SetBody(s)
@@ -57,28 +132,68 @@ func (bu *BlogUpdate) SetNillableBody(s *string) *BlogUpdate {
 	return bu
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (bu *BlogUpdate) SetCreatedAt(t time.Time) *BlogUpdate {
-	bu.mutation.SetCreatedAt(t)
+// SetStatus sets the "status" field.
+func (bu *BlogUpdate) SetStatus(b blog.Status) *BlogUpdate {
+	bu.mutation.SetStatus(b)
+	return bu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bu *BlogUpdate) SetNillableStatus(b *blog.Status) *BlogUpdate {
+	if b != nil {
+		bu.SetStatus(*b)
+	}
+	return bu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (bu *BlogUpdate) SetPublishedAt(t time.Time) *BlogUpdate {
+	bu.mutation.SetPublishedAt(t)
 	return bu
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (bu *BlogUpdate) SetNillableCreatedAt(t *time.Time) *BlogUpdate {
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (bu *BlogUpdate) SetNillablePublishedAt(t *time.Time) *BlogUpdate {
 	if t != nil {
-		bu.SetCreatedAt(*t)
+		bu.SetPublishedAt(*t)
 	}
 	return bu
 }
 
+// ClearPublishedAt clears the value of the "published_at" field.
+func (bu *BlogUpdate) ClearPublishedAt() *BlogUpdate {
+	bu.mutation.ClearPublishedAt()
+	return bu
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (bu *BlogUpdate) SetArchivedAt(t time.Time) *BlogUpdate {
+	bu.mutation.SetArchivedAt(t)
+	return bu
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (bu *BlogUpdate) SetNillableArchivedAt(t *time.Time) *BlogUpdate {
+	if t != nil {
+		bu.SetArchivedAt(*t)
+	}
+	return bu
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (bu *BlogUpdate) ClearArchivedAt() *BlogUpdate {
+	bu.mutation.ClearArchivedAt()
+	return bu
+}
+
 // SetAuthorID sets the "author" edge to the User entity by ID.
-func (bu *BlogUpdate) SetAuthorID(id int) *BlogUpdate {
+func (bu *BlogUpdate) SetAuthorID(id uuid.UUID) *BlogUpdate {
 	bu.mutation.SetAuthorID(id)
 	return bu
 }
 
 // SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
-func (bu *BlogUpdate) SetNillableAuthorID(id *int) *BlogUpdate {
+func (bu *BlogUpdate) SetNillableAuthorID(id *uuid.UUID) *BlogUpdate {
 	if id != nil {
 		bu = bu.SetAuthorID(*id)
 	}
@@ -90,6 +205,66 @@ func (bu *BlogUpdate) SetAuthor(u *User) *BlogUpdate {
 	return bu.SetAuthorID(u.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the BlogRevision entity by IDs.
+func (bu *BlogUpdate) AddRevisionIDs(ids ...int) *BlogUpdate {
+	bu.mutation.AddRevisionIDs(ids...)
+	return bu
+}
+
+// AddRevisions adds the "revisions" edges to the BlogRevision entity.
+func (bu *BlogUpdate) AddRevisions(b ...*BlogRevision) *BlogUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bu.AddRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (bu *BlogUpdate) AddTagIDs(ids ...int) *BlogUpdate {
+	bu.mutation.AddTagIDs(ids...)
+	return bu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (bu *BlogUpdate) AddTags(t ...*Tag) *BlogUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bu.AddTagIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (bu *BlogUpdate) AddCommentIDs(ids ...int) *BlogUpdate {
+	bu.mutation.AddCommentIDs(ids...)
+	return bu
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (bu *BlogUpdate) AddComments(c ...*Comment) *BlogUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return bu.AddCommentIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by IDs.
+func (bu *BlogUpdate) AddReactionIDs(ids ...int) *BlogUpdate {
+	bu.mutation.AddReactionIDs(ids...)
+	return bu
+}
+
+// AddReactions adds the "reactions" edges to the Reaction entity.
+func (bu *BlogUpdate) AddReactions(r ...*Reaction) *BlogUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bu.AddReactionIDs(ids...)
+}
+
 // Mutation returns the BlogMutation object of the builder.
 func (bu *BlogUpdate) Mutation() *BlogMutation {
 	return bu.mutation
@@ -101,6 +276,90 @@ func (bu *BlogUpdate) ClearAuthor() *BlogUpdate {
 	return bu
 }
 
+// ClearRevisions clears all "revisions" edges to the BlogRevision entity.
+func (bu *BlogUpdate) ClearRevisions() *BlogUpdate {
+	bu.mutation.ClearRevisions()
+	return bu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to BlogRevision entities by IDs.
+func (bu *BlogUpdate) RemoveRevisionIDs(ids ...int) *BlogUpdate {
+	bu.mutation.RemoveRevisionIDs(ids...)
+	return bu
+}
+
+// RemoveRevisions removes "revisions" edges to BlogRevision entities.
+func (bu *BlogUpdate) RemoveRevisions(b ...*BlogRevision) *BlogUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bu.RemoveRevisionIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (bu *BlogUpdate) ClearTags() *BlogUpdate {
+	bu.mutation.ClearTags()
+	return bu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (bu *BlogUpdate) RemoveTagIDs(ids ...int) *BlogUpdate {
+	bu.mutation.RemoveTagIDs(ids...)
+	return bu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (bu *BlogUpdate) RemoveTags(t ...*Tag) *BlogUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bu.RemoveTagIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (bu *BlogUpdate) ClearComments() *BlogUpdate {
+	bu.mutation.ClearComments()
+	return bu
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (bu *BlogUpdate) RemoveCommentIDs(ids ...int) *BlogUpdate {
+	bu.mutation.RemoveCommentIDs(ids...)
+	return bu
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (bu *BlogUpdate) RemoveComments(c ...*Comment) *BlogUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return bu.RemoveCommentIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the Reaction entity.
+func (bu *BlogUpdate) ClearReactions() *BlogUpdate {
+	bu.mutation.ClearReactions()
+	return bu
+}
+
+// RemoveReactionIDs removes the "reactions" edge to Reaction entities by IDs.
+func (bu *BlogUpdate) RemoveReactionIDs(ids ...int) *BlogUpdate {
+	bu.mutation.RemoveReactionIDs(ids...)
+	return bu
+}
+
+// RemoveReactions removes "reactions" edges to Reaction entities.
+func (bu *BlogUpdate) RemoveReactions(r ...*Reaction) *BlogUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bu.RemoveReactionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (bu *BlogUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
@@ -128,8 +387,40 @@ func (bu *BlogUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (bu *BlogUpdate) check() error {
+	if v, ok := bu.mutation.Version(); ok {
+		if err := blog.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Blog.version": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.Slug(); ok {
+		if err := blog.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Blog.slug": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.Status(); ok {
+		if err := blog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Blog.status": %w`, err)}
+		}
+	}
+	if _, ok := bu.mutation.TenantID(); bu.mutation.TenantCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Blog.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bu *BlogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlogUpdate {
+	bu.modifiers = append(bu.modifiers, modifiers...)
+	return bu
+}
+
 func (bu *BlogUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(blog.Table, blog.Columns, sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt))
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blog.Table, blog.Columns, sqlgraph.NewFieldSpec(blog.FieldID, field.TypeUUID))
 	if ps := bu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -137,14 +428,44 @@ func (bu *BlogUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := bu.mutation.UpdatedAt(); ok {
+		_spec.SetField(blog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := bu.mutation.DeletedAt(); ok {
+		_spec.SetField(blog.FieldDeletedAt, field.TypeTime, value)
+	}
+	if bu.mutation.DeletedAtCleared() {
+		_spec.ClearField(blog.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := bu.mutation.Version(); ok {
+		_spec.SetField(blog.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.AddedVersion(); ok {
+		_spec.AddField(blog.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := bu.mutation.Title(); ok {
 		_spec.SetField(blog.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := bu.mutation.Slug(); ok {
+		_spec.SetField(blog.FieldSlug, field.TypeString, value)
+	}
 	if value, ok := bu.mutation.Body(); ok {
 		_spec.SetField(blog.FieldBody, field.TypeString, value)
 	}
-	if value, ok := bu.mutation.CreatedAt(); ok {
-		_spec.SetField(blog.FieldCreatedAt, field.TypeTime, value)
+	if value, ok := bu.mutation.Status(); ok {
+		_spec.SetField(blog.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := bu.mutation.PublishedAt(); ok {
+		_spec.SetField(blog.FieldPublishedAt, field.TypeTime, value)
+	}
+	if bu.mutation.PublishedAtCleared() {
+		_spec.ClearField(blog.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := bu.mutation.ArchivedAt(); ok {
+		_spec.SetField(blog.FieldArchivedAt, field.TypeTime, value)
+	}
+	if bu.mutation.ArchivedAtCleared() {
+		_spec.ClearField(blog.FieldArchivedAt, field.TypeTime)
 	}
 	if bu.mutation.AuthorCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -154,7 +475,7 @@ func (bu *BlogUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Columns: []string{blog.AuthorColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
@@ -167,7 +488,187 @@ func (bu *BlogUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Columns: []string{blog.AuthorColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.RevisionsTable,
+			Columns: []string{blog.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !bu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.RevisionsTable,
+			Columns: []string{blog.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.RevisionsTable,
+			Columns: []string{blog.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !bu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.CommentsTable,
+			Columns: []string{blog.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !bu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.CommentsTable,
+			Columns: []string{blog.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.CommentsTable,
+			Columns: []string{blog.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ReactionsTable,
+			Columns: []string{blog.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !bu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ReactionsTable,
+			Columns: []string{blog.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ReactionsTable,
+			Columns: []string{blog.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -175,6 +676,7 @@ func (bu *BlogUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(bu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{blog.Label}
@@ -190,9 +692,65 @@ func (bu *BlogUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // BlogUpdateOne is the builder for updating a single Blog entity.
 type BlogUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *BlogMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *BlogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (buo *BlogUpdateOne) SetUpdatedAt(t time.Time) *BlogUpdateOne {
+	buo.mutation.SetUpdatedAt(t)
+	return buo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (buo *BlogUpdateOne) SetNillableUpdatedAt(t *time.Time) *BlogUpdateOne {
+	if t != nil {
+		buo.SetUpdatedAt(*t)
+	}
+	return buo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (buo *BlogUpdateOne) SetDeletedAt(t time.Time) *BlogUpdateOne {
+	buo.mutation.SetDeletedAt(t)
+	return buo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (buo *BlogUpdateOne) SetNillableDeletedAt(t *time.Time) *BlogUpdateOne {
+	if t != nil {
+		buo.SetDeletedAt(*t)
+	}
+	return buo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (buo *BlogUpdateOne) ClearDeletedAt() *BlogUpdateOne {
+	buo.mutation.ClearDeletedAt()
+	return buo
+}
+
+// SetVersion sets the "version" field.
+func (buo *BlogUpdateOne) SetVersion(i int) *BlogUpdateOne {
+	buo.mutation.ResetVersion()
+	buo.mutation.SetVersion(i)
+	return buo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (buo *BlogUpdateOne) SetNillableVersion(i *int) *BlogUpdateOne {
+	if i != nil {
+		buo.SetVersion(*i)
+	}
+	return buo
+}
+
+// AddVersion adds i to the "version" field.
+func (buo *BlogUpdateOne) AddVersion(i int) *BlogUpdateOne {
+	buo.mutation.AddVersion(i)
+	return buo
 }
 
 // SetTitle sets the "title" field.
@@ -209,6 +767,20 @@ func (buo *BlogUpdateOne) SetNillableTitle(s *string) *BlogUpdateOne {
 	return buo
 }
 
+// SetSlug sets the "slug" field.
+func (buo *BlogUpdateOne) SetSlug(s string) *BlogUpdateOne {
+	buo.mutation.SetSlug(s)
+	return buo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (buo *BlogUpdateOne) SetNillableSlug(s *string) *BlogUpdateOne {
+	if s != nil {
+		buo.SetSlug(*s)
+	}
+	return buo
+}
+
 // SetBody sets the "body" field.
 func (buo *BlogUpdateOne) SetBody(s string) *BlogUpdateOne {
 	buo.mutation.SetBody(s)
@@ -223,28 +795,68 @@ func (buo *BlogUpdateOne) SetNillableBody(s *string) *BlogUpdateOne {
 	return buo
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (buo *BlogUpdateOne) SetCreatedAt(t time.Time) *BlogUpdateOne {
-	buo.mutation.SetCreatedAt(t)
+// SetStatus sets the "status" field.
+func (buo *BlogUpdateOne) SetStatus(b blog.Status) *BlogUpdateOne {
+	buo.mutation.SetStatus(b)
+	return buo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (buo *BlogUpdateOne) SetNillableStatus(b *blog.Status) *BlogUpdateOne {
+	if b != nil {
+		buo.SetStatus(*b)
+	}
+	return buo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (buo *BlogUpdateOne) SetPublishedAt(t time.Time) *BlogUpdateOne {
+	buo.mutation.SetPublishedAt(t)
 	return buo
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (buo *BlogUpdateOne) SetNillableCreatedAt(t *time.Time) *BlogUpdateOne {
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (buo *BlogUpdateOne) SetNillablePublishedAt(t *time.Time) *BlogUpdateOne {
 	if t != nil {
-		buo.SetCreatedAt(*t)
+		buo.SetPublishedAt(*t)
 	}
 	return buo
 }
 
+// ClearPublishedAt clears the value of the "published_at" field.
+func (buo *BlogUpdateOne) ClearPublishedAt() *BlogUpdateOne {
+	buo.mutation.ClearPublishedAt()
+	return buo
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (buo *BlogUpdateOne) SetArchivedAt(t time.Time) *BlogUpdateOne {
+	buo.mutation.SetArchivedAt(t)
+	return buo
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (buo *BlogUpdateOne) SetNillableArchivedAt(t *time.Time) *BlogUpdateOne {
+	if t != nil {
+		buo.SetArchivedAt(*t)
+	}
+	return buo
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (buo *BlogUpdateOne) ClearArchivedAt() *BlogUpdateOne {
+	buo.mutation.ClearArchivedAt()
+	return buo
+}
+
 // SetAuthorID sets the "author" edge to the User entity by ID.
-func (buo *BlogUpdateOne) SetAuthorID(id int) *BlogUpdateOne {
+func (buo *BlogUpdateOne) SetAuthorID(id uuid.UUID) *BlogUpdateOne {
 	buo.mutation.SetAuthorID(id)
 	return buo
 }
 
 // SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
-func (buo *BlogUpdateOne) SetNillableAuthorID(id *int) *BlogUpdateOne {
+func (buo *BlogUpdateOne) SetNillableAuthorID(id *uuid.UUID) *BlogUpdateOne {
 	if id != nil {
 		buo = buo.SetAuthorID(*id)
 	}
@@ -256,6 +868,66 @@ func (buo *BlogUpdateOne) SetAuthor(u *User) *BlogUpdateOne {
 	return buo.SetAuthorID(u.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the BlogRevision entity by IDs.
+func (buo *BlogUpdateOne) AddRevisionIDs(ids ...int) *BlogUpdateOne {
+	buo.mutation.AddRevisionIDs(ids...)
+	return buo
+}
+
+// AddRevisions adds the "revisions" edges to the BlogRevision entity.
+func (buo *BlogUpdateOne) AddRevisions(b ...*BlogRevision) *BlogUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return buo.AddRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (buo *BlogUpdateOne) AddTagIDs(ids ...int) *BlogUpdateOne {
+	buo.mutation.AddTagIDs(ids...)
+	return buo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (buo *BlogUpdateOne) AddTags(t ...*Tag) *BlogUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return buo.AddTagIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (buo *BlogUpdateOne) AddCommentIDs(ids ...int) *BlogUpdateOne {
+	buo.mutation.AddCommentIDs(ids...)
+	return buo
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (buo *BlogUpdateOne) AddComments(c ...*Comment) *BlogUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return buo.AddCommentIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by IDs.
+func (buo *BlogUpdateOne) AddReactionIDs(ids ...int) *BlogUpdateOne {
+	buo.mutation.AddReactionIDs(ids...)
+	return buo
+}
+
+// AddReactions adds the "reactions" edges to the Reaction entity.
+func (buo *BlogUpdateOne) AddReactions(r ...*Reaction) *BlogUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return buo.AddReactionIDs(ids...)
+}
+
 // Mutation returns the BlogMutation object of the builder.
 func (buo *BlogUpdateOne) Mutation() *BlogMutation {
 	return buo.mutation
@@ -267,6 +939,90 @@ func (buo *BlogUpdateOne) ClearAuthor() *BlogUpdateOne {
 	return buo
 }
 
+// ClearRevisions clears all "revisions" edges to the BlogRevision entity.
+func (buo *BlogUpdateOne) ClearRevisions() *BlogUpdateOne {
+	buo.mutation.ClearRevisions()
+	return buo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to BlogRevision entities by IDs.
+func (buo *BlogUpdateOne) RemoveRevisionIDs(ids ...int) *BlogUpdateOne {
+	buo.mutation.RemoveRevisionIDs(ids...)
+	return buo
+}
+
+// RemoveRevisions removes "revisions" edges to BlogRevision entities.
+func (buo *BlogUpdateOne) RemoveRevisions(b ...*BlogRevision) *BlogUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return buo.RemoveRevisionIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (buo *BlogUpdateOne) ClearTags() *BlogUpdateOne {
+	buo.mutation.ClearTags()
+	return buo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (buo *BlogUpdateOne) RemoveTagIDs(ids ...int) *BlogUpdateOne {
+	buo.mutation.RemoveTagIDs(ids...)
+	return buo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (buo *BlogUpdateOne) RemoveTags(t ...*Tag) *BlogUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return buo.RemoveTagIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (buo *BlogUpdateOne) ClearComments() *BlogUpdateOne {
+	buo.mutation.ClearComments()
+	return buo
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (buo *BlogUpdateOne) RemoveCommentIDs(ids ...int) *BlogUpdateOne {
+	buo.mutation.RemoveCommentIDs(ids...)
+	return buo
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (buo *BlogUpdateOne) RemoveComments(c ...*Comment) *BlogUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return buo.RemoveCommentIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the Reaction entity.
+func (buo *BlogUpdateOne) ClearReactions() *BlogUpdateOne {
+	buo.mutation.ClearReactions()
+	return buo
+}
+
+// RemoveReactionIDs removes the "reactions" edge to Reaction entities by IDs.
+func (buo *BlogUpdateOne) RemoveReactionIDs(ids ...int) *BlogUpdateOne {
+	buo.mutation.RemoveReactionIDs(ids...)
+	return buo
+}
+
+// RemoveReactions removes "reactions" edges to Reaction entities.
+func (buo *BlogUpdateOne) RemoveReactions(r ...*Reaction) *BlogUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return buo.RemoveReactionIDs(ids...)
+}
+
 // Where appends a list predicates to the BlogUpdate builder.
 func (buo *BlogUpdateOne) Where(ps ...predicate.Blog) *BlogUpdateOne {
 	buo.mutation.Where(ps...)
@@ -307,8 +1063,40 @@ func (buo *BlogUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (buo *BlogUpdateOne) check() error {
+	if v, ok := buo.mutation.Version(); ok {
+		if err := blog.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Blog.version": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.Slug(); ok {
+		if err := blog.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Blog.slug": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.Status(); ok {
+		if err := blog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Blog.status": %w`, err)}
+		}
+	}
+	if _, ok := buo.mutation.TenantID(); buo.mutation.TenantCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Blog.tenant"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (buo *BlogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlogUpdateOne {
+	buo.modifiers = append(buo.modifiers, modifiers...)
+	return buo
+}
+
 func (buo *BlogUpdateOne) sqlSave(ctx context.Context) (_node *Blog, err error) {
-	_spec := sqlgraph.NewUpdateSpec(blog.Table, blog.Columns, sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt))
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blog.Table, blog.Columns, sqlgraph.NewFieldSpec(blog.FieldID, field.TypeUUID))
 	id, ok := buo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Blog.id" for update`)}
@@ -333,14 +1121,44 @@ func (buo *BlogUpdateOne) sqlSave(ctx context.Context) (_node *Blog, err error)
 			}
 		}
 	}
+	if value, ok := buo.mutation.UpdatedAt(); ok {
+		_spec.SetField(blog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := buo.mutation.DeletedAt(); ok {
+		_spec.SetField(blog.FieldDeletedAt, field.TypeTime, value)
+	}
+	if buo.mutation.DeletedAtCleared() {
+		_spec.ClearField(blog.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := buo.mutation.Version(); ok {
+		_spec.SetField(blog.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.AddedVersion(); ok {
+		_spec.AddField(blog.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := buo.mutation.Title(); ok {
 		_spec.SetField(blog.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := buo.mutation.Slug(); ok {
+		_spec.SetField(blog.FieldSlug, field.TypeString, value)
+	}
 	if value, ok := buo.mutation.Body(); ok {
 		_spec.SetField(blog.FieldBody, field.TypeString, value)
 	}
-	if value, ok := buo.mutation.CreatedAt(); ok {
-		_spec.SetField(blog.FieldCreatedAt, field.TypeTime, value)
+	if value, ok := buo.mutation.Status(); ok {
+		_spec.SetField(blog.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := buo.mutation.PublishedAt(); ok {
+		_spec.SetField(blog.FieldPublishedAt, field.TypeTime, value)
+	}
+	if buo.mutation.PublishedAtCleared() {
+		_spec.ClearField(blog.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := buo.mutation.ArchivedAt(); ok {
+		_spec.SetField(blog.FieldArchivedAt, field.TypeTime, value)
+	}
+	if buo.mutation.ArchivedAtCleared() {
+		_spec.ClearField(blog.FieldArchivedAt, field.TypeTime)
 	}
 	if buo.mutation.AuthorCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -350,7 +1168,7 @@ func (buo *BlogUpdateOne) sqlSave(ctx context.Context) (_node *Blog, err error)
 			Columns: []string{blog.AuthorColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
@@ -363,7 +1181,187 @@ func (buo *BlogUpdateOne) sqlSave(ctx context.Context) (_node *Blog, err error)
 			Columns: []string{blog.AuthorColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.RevisionsTable,
+			Columns: []string{blog.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !buo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.RevisionsTable,
+			Columns: []string{blog.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.RevisionsTable,
+			Columns: []string{blog.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !buo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.CommentsTable,
+			Columns: []string{blog.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !buo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.CommentsTable,
+			Columns: []string{blog.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.CommentsTable,
+			Columns: []string{blog.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ReactionsTable,
+			Columns: []string{blog.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !buo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ReactionsTable,
+			Columns: []string{blog.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ReactionsTable,
+			Columns: []string{blog.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -371,6 +1369,7 @@ func (buo *BlogUpdateOne) sqlSave(ctx context.Context) (_node *Blog, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(buo.modifiers...)
 	_node = &Blog{config: buo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/tenant"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// BlogRevision is the model entity for the BlogRevision schema.
+type BlogRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// BlogID holds the value of the "blog_id" field.
+	BlogID uuid.UUID `json:"blog_id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number int `json:"number,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlogRevisionQuery when eager-loading is set.
+	Edges        BlogRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BlogRevisionEdges holds the relations/edges for other nodes in the graph.
+type BlogRevisionEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Blog holds the value of the blog edge.
+	Blog *Blog `json:"blog,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogRevisionEdges) TenantOrErr() (*Tenant, error) {
+	if e.loadedTypes[0] {
+		if e.Tenant == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: tenant.Label}
+		}
+		return e.Tenant, nil
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// BlogOrErr returns the Blog value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogRevisionEdges) BlogOrErr() (*Blog, error) {
+	if e.loadedTypes[1] {
+		if e.Blog == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: blog.Label}
+		}
+		return e.Blog, nil
+	}
+	return nil, &NotLoadedError{edge: "blog"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BlogRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blogrevision.FieldID, blogrevision.FieldNumber:
+			values[i] = new(sql.NullInt64)
+		case blogrevision.FieldTitle, blogrevision.FieldBody:
+			values[i] = new(sql.NullString)
+		case blogrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case blogrevision.FieldTenantID, blogrevision.FieldBlogID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BlogRevision fields.
+func (br *BlogRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blogrevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			br.ID = int(value.Int64)
+		case blogrevision.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				br.TenantID = *value
+			}
+		case blogrevision.FieldBlogID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field blog_id", values[i])
+			} else if value != nil {
+				br.BlogID = *value
+			}
+		case blogrevision.FieldNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				br.Number = int(value.Int64)
+			}
+		case blogrevision.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				br.Title = value.String
+			}
+		case blogrevision.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				br.Body = value.String
+			}
+		case blogrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				br.CreatedAt = value.Time
+			}
+		default:
+			br.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BlogRevision.
+// This includes values selected through modifiers, order, etc.
+func (br *BlogRevision) Value(name string) (ent.Value, error) {
+	return br.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the BlogRevision entity.
+func (br *BlogRevision) QueryTenant() *TenantQuery {
+	return NewBlogRevisionClient(br.config).QueryTenant(br)
+}
+
+// QueryBlog queries the "blog" edge of the BlogRevision entity.
+func (br *BlogRevision) QueryBlog() *BlogQuery {
+	return NewBlogRevisionClient(br.config).QueryBlog(br)
+}
+
+// Update returns a builder for updating this BlogRevision.
+// Note that you need to call BlogRevision.Unwrap() before calling this method if this BlogRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (br *BlogRevision) Update() *BlogRevisionUpdateOne {
+	return NewBlogRevisionClient(br.config).UpdateOne(br)
+}
+
+// Unwrap unwraps the BlogRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (br *BlogRevision) Unwrap() *BlogRevision {
+	_tx, ok := br.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlogRevision is not a transactional entity")
+	}
+	br.config.driver = _tx.drv
+	return br
+}
+
+// String implements the fmt.Stringer.
+func (br *BlogRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("BlogRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", br.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", br.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("blog_id=")
+	builder.WriteString(fmt.Sprintf("%v", br.BlogID))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(fmt.Sprintf("%v", br.Number))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(br.Title)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(br.Body)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(br.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BlogRevisions is a parsable slice of BlogRevision.
+type BlogRevisions []*BlogRevision
@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/tenant"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BlogRevisionCreate is the builder for creating a BlogRevision entity.
+type BlogRevisionCreate struct {
+	config
+	mutation *BlogRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (brc *BlogRevisionCreate) SetTenantID(u uuid.UUID) *BlogRevisionCreate {
+	brc.mutation.SetTenantID(u)
+	return brc
+}
+
+// SetBlogID sets the "blog_id" field.
+func (brc *BlogRevisionCreate) SetBlogID(u uuid.UUID) *BlogRevisionCreate {
+	brc.mutation.SetBlogID(u)
+	return brc
+}
+
+// SetNumber sets the "number" field.
+func (brc *BlogRevisionCreate) SetNumber(i int) *BlogRevisionCreate {
+	brc.mutation.SetNumber(i)
+	return brc
+}
+
+// SetTitle sets the "title" field.
+func (brc *BlogRevisionCreate) SetTitle(s string) *BlogRevisionCreate {
+	brc.mutation.SetTitle(s)
+	return brc
+}
+
+// SetBody sets the "body" field.
+func (brc *BlogRevisionCreate) SetBody(s string) *BlogRevisionCreate {
+	brc.mutation.SetBody(s)
+	return brc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (brc *BlogRevisionCreate) SetCreatedAt(t time.Time) *BlogRevisionCreate {
+	brc.mutation.SetCreatedAt(t)
+	return brc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (brc *BlogRevisionCreate) SetNillableCreatedAt(t *time.Time) *BlogRevisionCreate {
+	if t != nil {
+		brc.SetCreatedAt(*t)
+	}
+	return brc
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (brc *BlogRevisionCreate) SetTenant(t *Tenant) *BlogRevisionCreate {
+	return brc.SetTenantID(t.ID)
+}
+
+// SetBlog sets the "blog" edge to the Blog entity.
+func (brc *BlogRevisionCreate) SetBlog(b *Blog) *BlogRevisionCreate {
+	return brc.SetBlogID(b.ID)
+}
+
+// Mutation returns the BlogRevisionMutation object of the builder.
+func (brc *BlogRevisionCreate) Mutation() *BlogRevisionMutation {
+	return brc.mutation
+}
+
+// Save creates the BlogRevision in the database.
+func (brc *BlogRevisionCreate) Save(ctx context.Context) (*BlogRevision, error) {
+	if err := brc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, brc.sqlSave, brc.mutation, brc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (brc *BlogRevisionCreate) SaveX(ctx context.Context) *BlogRevision {
+	v, err := brc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (brc *BlogRevisionCreate) Exec(ctx context.Context) error {
+	_, err := brc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brc *BlogRevisionCreate) ExecX(ctx context.Context) {
+	if err := brc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (brc *BlogRevisionCreate) defaults() error {
+	if _, ok := brc.mutation.CreatedAt(); !ok {
+		if blogrevision.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized blogrevision.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := blogrevision.DefaultCreatedAt()
+		brc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (brc *BlogRevisionCreate) check() error {
+	if _, ok := brc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "BlogRevision.tenant_id"`)}
+	}
+	if _, ok := brc.mutation.BlogID(); !ok {
+		return &ValidationError{Name: "blog_id", err: errors.New(`ent: missing required field "BlogRevision.blog_id"`)}
+	}
+	if _, ok := brc.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "BlogRevision.number"`)}
+	}
+	if v, ok := brc.mutation.Number(); ok {
+		if err := blogrevision.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "BlogRevision.number": %w`, err)}
+		}
+	}
+	if _, ok := brc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "BlogRevision.title"`)}
+	}
+	if _, ok := brc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "BlogRevision.body"`)}
+	}
+	if _, ok := brc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BlogRevision.created_at"`)}
+	}
+	if _, ok := brc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "BlogRevision.tenant"`)}
+	}
+	if _, ok := brc.mutation.BlogID(); !ok {
+		return &ValidationError{Name: "blog", err: errors.New(`ent: missing required edge "BlogRevision.blog"`)}
+	}
+	return nil
+}
+
+func (brc *BlogRevisionCreate) sqlSave(ctx context.Context) (*BlogRevision, error) {
+	if err := brc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := brc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, brc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	brc.mutation.id = &_node.ID
+	brc.mutation.done = true
+	return _node, nil
+}
+
+func (brc *BlogRevisionCreate) createSpec() (*BlogRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BlogRevision{config: brc.config}
+		_spec = sqlgraph.NewCreateSpec(blogrevision.Table, sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = brc.conflict
+	if value, ok := brc.mutation.Number(); ok {
+		_spec.SetField(blogrevision.FieldNumber, field.TypeInt, value)
+		_node.Number = value
+	}
+	if value, ok := brc.mutation.Title(); ok {
+		_spec.SetField(blogrevision.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := brc.mutation.Body(); ok {
+		_spec.SetField(blogrevision.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := brc.mutation.CreatedAt(); ok {
+		_spec.SetField(blogrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := brc.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   blogrevision.TenantTable,
+			Columns: []string{blogrevision.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := brc.mutation.BlogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogrevision.BlogTable,
+			Columns: []string{blogrevision.BlogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BlogID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BlogRevision.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlogRevisionUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (brc *BlogRevisionCreate) OnConflict(opts ...sql.ConflictOption) *BlogRevisionUpsertOne {
+	brc.conflict = opts
+	return &BlogRevisionUpsertOne{
+		create: brc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BlogRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (brc *BlogRevisionCreate) OnConflictColumns(columns ...string) *BlogRevisionUpsertOne {
+	brc.conflict = append(brc.conflict, sql.ConflictColumns(columns...))
+	return &BlogRevisionUpsertOne{
+		create: brc,
+	}
+}
+
+type (
+	// BlogRevisionUpsertOne is the builder for "upsert"-ing
+	//  one BlogRevision node.
+	BlogRevisionUpsertOne struct {
+		create *BlogRevisionCreate
+	}
+
+	// BlogRevisionUpsert is the "OnConflict" setter.
+	BlogRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BlogRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BlogRevisionUpsertOne) UpdateNewValues() *BlogRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(blogrevision.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.BlogID(); exists {
+			s.SetIgnore(blogrevision.FieldBlogID)
+		}
+		if _, exists := u.create.mutation.Number(); exists {
+			s.SetIgnore(blogrevision.FieldNumber)
+		}
+		if _, exists := u.create.mutation.Title(); exists {
+			s.SetIgnore(blogrevision.FieldTitle)
+		}
+		if _, exists := u.create.mutation.Body(); exists {
+			s.SetIgnore(blogrevision.FieldBody)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(blogrevision.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BlogRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BlogRevisionUpsertOne) Ignore() *BlogRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlogRevisionUpsertOne) DoNothing() *BlogRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlogRevisionCreate.OnConflict
+// documentation for more info.
+func (u *BlogRevisionUpsertOne) Update(set func(*BlogRevisionUpsert)) *BlogRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlogRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *BlogRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlogRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlogRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BlogRevisionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BlogRevisionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BlogRevisionCreateBulk is the builder for creating many BlogRevision entities in bulk.
+type BlogRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*BlogRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BlogRevision entities in the database.
+func (brcb *BlogRevisionCreateBulk) Save(ctx context.Context) ([]*BlogRevision, error) {
+	if brcb.err != nil {
+		return nil, brcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(brcb.builders))
+	nodes := make([]*BlogRevision, len(brcb.builders))
+	mutators := make([]Mutator, len(brcb.builders))
+	for i := range brcb.builders {
+		func(i int, root context.Context) {
+			builder := brcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlogRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, brcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = brcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, brcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, brcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (brcb *BlogRevisionCreateBulk) SaveX(ctx context.Context) []*BlogRevision {
+	v, err := brcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (brcb *BlogRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := brcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brcb *BlogRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := brcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BlogRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlogRevisionUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (brcb *BlogRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *BlogRevisionUpsertBulk {
+	brcb.conflict = opts
+	return &BlogRevisionUpsertBulk{
+		create: brcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BlogRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (brcb *BlogRevisionCreateBulk) OnConflictColumns(columns ...string) *BlogRevisionUpsertBulk {
+	brcb.conflict = append(brcb.conflict, sql.ConflictColumns(columns...))
+	return &BlogRevisionUpsertBulk{
+		create: brcb,
+	}
+}
+
+// BlogRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of BlogRevision nodes.
+type BlogRevisionUpsertBulk struct {
+	create *BlogRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BlogRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BlogRevisionUpsertBulk) UpdateNewValues() *BlogRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(blogrevision.FieldTenantID)
+			}
+			if _, exists := b.mutation.BlogID(); exists {
+				s.SetIgnore(blogrevision.FieldBlogID)
+			}
+			if _, exists := b.mutation.Number(); exists {
+				s.SetIgnore(blogrevision.FieldNumber)
+			}
+			if _, exists := b.mutation.Title(); exists {
+				s.SetIgnore(blogrevision.FieldTitle)
+			}
+			if _, exists := b.mutation.Body(); exists {
+				s.SetIgnore(blogrevision.FieldBody)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(blogrevision.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BlogRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BlogRevisionUpsertBulk) Ignore() *BlogRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlogRevisionUpsertBulk) DoNothing() *BlogRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlogRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *BlogRevisionUpsertBulk) Update(set func(*BlogRevisionUpsert)) *BlogRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlogRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *BlogRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BlogRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlogRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlogRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
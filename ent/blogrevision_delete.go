@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlogRevisionDelete is the builder for deleting a BlogRevision entity.
+type BlogRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *BlogRevisionMutation
+}
+
+// Where appends a list predicates to the BlogRevisionDelete builder.
+func (brd *BlogRevisionDelete) Where(ps ...predicate.BlogRevision) *BlogRevisionDelete {
+	brd.mutation.Where(ps...)
+	return brd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (brd *BlogRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, brd.sqlExec, brd.mutation, brd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brd *BlogRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := brd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (brd *BlogRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(blogrevision.Table, sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt))
+	if ps := brd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, brd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	brd.mutation.done = true
+	return affected, err
+}
+
+// BlogRevisionDeleteOne is the builder for deleting a single BlogRevision entity.
+type BlogRevisionDeleteOne struct {
+	brd *BlogRevisionDelete
+}
+
+// Where appends a list predicates to the BlogRevisionDelete builder.
+func (brdo *BlogRevisionDeleteOne) Where(ps ...predicate.BlogRevision) *BlogRevisionDeleteOne {
+	brdo.brd.mutation.Where(ps...)
+	return brdo
+}
+
+// Exec executes the deletion query.
+func (brdo *BlogRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := brdo.brd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{blogrevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brdo *BlogRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := brdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
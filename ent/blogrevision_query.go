@@ -0,0 +1,703 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/predicate"
+	"testMigrationEntgo/ent/tenant"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BlogRevisionQuery is the builder for querying BlogRevision entities.
+type BlogRevisionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []blogrevision.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BlogRevision
+	withTenant *TenantQuery
+	withBlog   *BlogQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BlogRevisionQuery builder.
+func (brq *BlogRevisionQuery) Where(ps ...predicate.BlogRevision) *BlogRevisionQuery {
+	brq.predicates = append(brq.predicates, ps...)
+	return brq
+}
+
+// Limit the number of records to be returned by this query.
+func (brq *BlogRevisionQuery) Limit(limit int) *BlogRevisionQuery {
+	brq.ctx.Limit = &limit
+	return brq
+}
+
+// Offset to start from.
+func (brq *BlogRevisionQuery) Offset(offset int) *BlogRevisionQuery {
+	brq.ctx.Offset = &offset
+	return brq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (brq *BlogRevisionQuery) Unique(unique bool) *BlogRevisionQuery {
+	brq.ctx.Unique = &unique
+	return brq
+}
+
+// Order specifies how the records should be ordered.
+func (brq *BlogRevisionQuery) Order(o ...blogrevision.OrderOption) *BlogRevisionQuery {
+	brq.order = append(brq.order, o...)
+	return brq
+}
+
+// QueryTenant chains the current query on the "tenant" edge.
+func (brq *BlogRevisionQuery) QueryTenant() *TenantQuery {
+	query := (&TenantClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blogrevision.Table, blogrevision.FieldID, selector),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, blogrevision.TenantTable, blogrevision.TenantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlog chains the current query on the "blog" edge.
+func (brq *BlogRevisionQuery) QueryBlog() *BlogQuery {
+	query := (&BlogClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blogrevision.Table, blogrevision.FieldID, selector),
+			sqlgraph.To(blog.Table, blog.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blogrevision.BlogTable, blogrevision.BlogColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BlogRevision entity from the query.
+// Returns a *NotFoundError when no BlogRevision was found.
+func (brq *BlogRevisionQuery) First(ctx context.Context) (*BlogRevision, error) {
+	nodes, err := brq.Limit(1).All(setContextOp(ctx, brq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{blogrevision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (brq *BlogRevisionQuery) FirstX(ctx context.Context) *BlogRevision {
+	node, err := brq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BlogRevision ID from the query.
+// Returns a *NotFoundError when no BlogRevision ID was found.
+func (brq *BlogRevisionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = brq.Limit(1).IDs(setContextOp(ctx, brq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{blogrevision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (brq *BlogRevisionQuery) FirstIDX(ctx context.Context) int {
+	id, err := brq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BlogRevision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BlogRevision entity is found.
+// Returns a *NotFoundError when no BlogRevision entities are found.
+func (brq *BlogRevisionQuery) Only(ctx context.Context) (*BlogRevision, error) {
+	nodes, err := brq.Limit(2).All(setContextOp(ctx, brq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{blogrevision.Label}
+	default:
+		return nil, &NotSingularError{blogrevision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (brq *BlogRevisionQuery) OnlyX(ctx context.Context) *BlogRevision {
+	node, err := brq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BlogRevision ID in the query.
+// Returns a *NotSingularError when more than one BlogRevision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (brq *BlogRevisionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = brq.Limit(2).IDs(setContextOp(ctx, brq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{blogrevision.Label}
+	default:
+		err = &NotSingularError{blogrevision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (brq *BlogRevisionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := brq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BlogRevisions.
+func (brq *BlogRevisionQuery) All(ctx context.Context) ([]*BlogRevision, error) {
+	ctx = setContextOp(ctx, brq.ctx, "All")
+	if err := brq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BlogRevision, *BlogRevisionQuery]()
+	return withInterceptors[[]*BlogRevision](ctx, brq, qr, brq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (brq *BlogRevisionQuery) AllX(ctx context.Context) []*BlogRevision {
+	nodes, err := brq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BlogRevision IDs.
+func (brq *BlogRevisionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if brq.ctx.Unique == nil && brq.path != nil {
+		brq.Unique(true)
+	}
+	ctx = setContextOp(ctx, brq.ctx, "IDs")
+	if err = brq.Select(blogrevision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (brq *BlogRevisionQuery) IDsX(ctx context.Context) []int {
+	ids, err := brq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (brq *BlogRevisionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, brq.ctx, "Count")
+	if err := brq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, brq, querierCount[*BlogRevisionQuery](), brq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (brq *BlogRevisionQuery) CountX(ctx context.Context) int {
+	count, err := brq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (brq *BlogRevisionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, brq.ctx, "Exist")
+	switch _, err := brq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (brq *BlogRevisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := brq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BlogRevisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (brq *BlogRevisionQuery) Clone() *BlogRevisionQuery {
+	if brq == nil {
+		return nil
+	}
+	return &BlogRevisionQuery{
+		config:     brq.config,
+		ctx:        brq.ctx.Clone(),
+		order:      append([]blogrevision.OrderOption{}, brq.order...),
+		inters:     append([]Interceptor{}, brq.inters...),
+		predicates: append([]predicate.BlogRevision{}, brq.predicates...),
+		withTenant: brq.withTenant.Clone(),
+		withBlog:   brq.withBlog.Clone(),
+		// clone intermediate query.
+		sql:  brq.sql.Clone(),
+		path: brq.path,
+	}
+}
+
+// WithTenant tells the query-builder to eager-load the nodes that are connected to
+// the "tenant" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BlogRevisionQuery) WithTenant(opts ...func(*TenantQuery)) *BlogRevisionQuery {
+	query := (&TenantClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withTenant = query
+	return brq
+}
+
+// WithBlog tells the query-builder to eager-load the nodes that are connected to
+// the "blog" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BlogRevisionQuery) WithBlog(opts ...func(*BlogQuery)) *BlogRevisionQuery {
+	query := (&BlogClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withBlog = query
+	return brq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID uuid.UUID `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BlogRevision.Query().
+//		GroupBy(blogrevision.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (brq *BlogRevisionQuery) GroupBy(field string, fields ...string) *BlogRevisionGroupBy {
+	brq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BlogRevisionGroupBy{build: brq}
+	grbuild.flds = &brq.ctx.Fields
+	grbuild.label = blogrevision.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID uuid.UUID `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.BlogRevision.Query().
+//		Select(blogrevision.FieldTenantID).
+//		Scan(ctx, &v)
+func (brq *BlogRevisionQuery) Select(fields ...string) *BlogRevisionSelect {
+	brq.ctx.Fields = append(brq.ctx.Fields, fields...)
+	sbuild := &BlogRevisionSelect{BlogRevisionQuery: brq}
+	sbuild.label = blogrevision.Label
+	sbuild.flds, sbuild.scan = &brq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BlogRevisionSelect configured with the given aggregations.
+func (brq *BlogRevisionQuery) Aggregate(fns ...AggregateFunc) *BlogRevisionSelect {
+	return brq.Select().Aggregate(fns...)
+}
+
+func (brq *BlogRevisionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range brq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, brq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range brq.ctx.Fields {
+		if !blogrevision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if brq.path != nil {
+		prev, err := brq.path(ctx)
+		if err != nil {
+			return err
+		}
+		brq.sql = prev
+	}
+	return nil
+}
+
+func (brq *BlogRevisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BlogRevision, error) {
+	var (
+		nodes       = []*BlogRevision{}
+		_spec       = brq.querySpec()
+		loadedTypes = [2]bool{
+			brq.withTenant != nil,
+			brq.withBlog != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BlogRevision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BlogRevision{config: brq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(brq.modifiers) > 0 {
+		_spec.Modifiers = brq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, brq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := brq.withTenant; query != nil {
+		if err := brq.loadTenant(ctx, query, nodes, nil,
+			func(n *BlogRevision, e *Tenant) { n.Edges.Tenant = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := brq.withBlog; query != nil {
+		if err := brq.loadBlog(ctx, query, nodes, nil,
+			func(n *BlogRevision, e *Blog) { n.Edges.Blog = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (brq *BlogRevisionQuery) loadTenant(ctx context.Context, query *TenantQuery, nodes []*BlogRevision, init func(*BlogRevision), assign func(*BlogRevision, *Tenant)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BlogRevision)
+	for i := range nodes {
+		fk := nodes[i].TenantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tenant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tenant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (brq *BlogRevisionQuery) loadBlog(ctx context.Context, query *BlogQuery, nodes []*BlogRevision, init func(*BlogRevision), assign func(*BlogRevision, *Blog)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BlogRevision)
+	for i := range nodes {
+		fk := nodes[i].BlogID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(blog.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "blog_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (brq *BlogRevisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := brq.querySpec()
+	if len(brq.modifiers) > 0 {
+		_spec.Modifiers = brq.modifiers
+	}
+	_spec.Node.Columns = brq.ctx.Fields
+	if len(brq.ctx.Fields) > 0 {
+		_spec.Unique = brq.ctx.Unique != nil && *brq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, brq.driver, _spec)
+}
+
+func (brq *BlogRevisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(blogrevision.Table, blogrevision.Columns, sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt))
+	_spec.From = brq.sql
+	if unique := brq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if brq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := brq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blogrevision.FieldID)
+		for i := range fields {
+			if fields[i] != blogrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if brq.withTenant != nil {
+			_spec.Node.AddColumnOnce(blogrevision.FieldTenantID)
+		}
+		if brq.withBlog != nil {
+			_spec.Node.AddColumnOnce(blogrevision.FieldBlogID)
+		}
+	}
+	if ps := brq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := brq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := brq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := brq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (brq *BlogRevisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(brq.driver.Dialect())
+	t1 := builder.Table(blogrevision.Table)
+	columns := brq.ctx.Fields
+	if len(columns) == 0 {
+		columns = blogrevision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if brq.sql != nil {
+		selector = brq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if brq.ctx.Unique != nil && *brq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range brq.modifiers {
+		m(selector)
+	}
+	for _, p := range brq.predicates {
+		p(selector)
+	}
+	for _, p := range brq.order {
+		p(selector)
+	}
+	if offset := brq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := brq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (brq *BlogRevisionQuery) Modify(modifiers ...func(s *sql.Selector)) *BlogRevisionSelect {
+	brq.modifiers = append(brq.modifiers, modifiers...)
+	return brq.Select()
+}
+
+// BlogRevisionGroupBy is the group-by builder for BlogRevision entities.
+type BlogRevisionGroupBy struct {
+	selector
+	build *BlogRevisionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (brgb *BlogRevisionGroupBy) Aggregate(fns ...AggregateFunc) *BlogRevisionGroupBy {
+	brgb.fns = append(brgb.fns, fns...)
+	return brgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (brgb *BlogRevisionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, brgb.build.ctx, "GroupBy")
+	if err := brgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlogRevisionQuery, *BlogRevisionGroupBy](ctx, brgb.build, brgb, brgb.build.inters, v)
+}
+
+func (brgb *BlogRevisionGroupBy) sqlScan(ctx context.Context, root *BlogRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(brgb.fns))
+	for _, fn := range brgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*brgb.flds)+len(brgb.fns))
+		for _, f := range *brgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*brgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := brgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BlogRevisionSelect is the builder for selecting fields of BlogRevision entities.
+type BlogRevisionSelect struct {
+	*BlogRevisionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (brs *BlogRevisionSelect) Aggregate(fns ...AggregateFunc) *BlogRevisionSelect {
+	brs.fns = append(brs.fns, fns...)
+	return brs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (brs *BlogRevisionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, brs.ctx, "Select")
+	if err := brs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlogRevisionQuery, *BlogRevisionSelect](ctx, brs.BlogRevisionQuery, brs, brs.inters, v)
+}
+
+func (brs *BlogRevisionSelect) sqlScan(ctx context.Context, root *BlogRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(brs.fns))
+	for _, fn := range brs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*brs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := brs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (brs *BlogRevisionSelect) Modify(modifiers ...func(s *sql.Selector)) *BlogRevisionSelect {
+	brs.modifiers = append(brs.modifiers, modifiers...)
+	return brs
+}
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlogRevisionUpdate is the builder for updating BlogRevision entities.
+type BlogRevisionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BlogRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BlogRevisionUpdate builder.
+func (bru *BlogRevisionUpdate) Where(ps ...predicate.BlogRevision) *BlogRevisionUpdate {
+	bru.mutation.Where(ps...)
+	return bru
+}
+
+// Mutation returns the BlogRevisionMutation object of the builder.
+func (bru *BlogRevisionUpdate) Mutation() *BlogRevisionMutation {
+	return bru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bru *BlogRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bru.sqlSave, bru.mutation, bru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bru *BlogRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := bru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bru *BlogRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := bru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bru *BlogRevisionUpdate) ExecX(ctx context.Context) {
+	if err := bru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bru *BlogRevisionUpdate) check() error {
+	if _, ok := bru.mutation.TenantID(); bru.mutation.TenantCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "BlogRevision.tenant"`)
+	}
+	if _, ok := bru.mutation.BlogID(); bru.mutation.BlogCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "BlogRevision.blog"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bru *BlogRevisionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlogRevisionUpdate {
+	bru.modifiers = append(bru.modifiers, modifiers...)
+	return bru
+}
+
+func (bru *BlogRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blogrevision.Table, blogrevision.Columns, sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt))
+	if ps := bru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(bru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, bru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blogrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bru.mutation.done = true
+	return n, nil
+}
+
+// BlogRevisionUpdateOne is the builder for updating a single BlogRevision entity.
+type BlogRevisionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BlogRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the BlogRevisionMutation object of the builder.
+func (bruo *BlogRevisionUpdateOne) Mutation() *BlogRevisionMutation {
+	return bruo.mutation
+}
+
+// Where appends a list predicates to the BlogRevisionUpdate builder.
+func (bruo *BlogRevisionUpdateOne) Where(ps ...predicate.BlogRevision) *BlogRevisionUpdateOne {
+	bruo.mutation.Where(ps...)
+	return bruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bruo *BlogRevisionUpdateOne) Select(field string, fields ...string) *BlogRevisionUpdateOne {
+	bruo.fields = append([]string{field}, fields...)
+	return bruo
+}
+
+// Save executes the query and returns the updated BlogRevision entity.
+func (bruo *BlogRevisionUpdateOne) Save(ctx context.Context) (*BlogRevision, error) {
+	return withHooks(ctx, bruo.sqlSave, bruo.mutation, bruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bruo *BlogRevisionUpdateOne) SaveX(ctx context.Context) *BlogRevision {
+	node, err := bruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bruo *BlogRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := bruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bruo *BlogRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := bruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bruo *BlogRevisionUpdateOne) check() error {
+	if _, ok := bruo.mutation.TenantID(); bruo.mutation.TenantCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "BlogRevision.tenant"`)
+	}
+	if _, ok := bruo.mutation.BlogID(); bruo.mutation.BlogCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "BlogRevision.blog"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bruo *BlogRevisionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlogRevisionUpdateOne {
+	bruo.modifiers = append(bruo.modifiers, modifiers...)
+	return bruo
+}
+
+func (bruo *BlogRevisionUpdateOne) sqlSave(ctx context.Context) (_node *BlogRevision, err error) {
+	if err := bruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blogrevision.Table, blogrevision.Columns, sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt))
+	id, ok := bruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BlogRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blogrevision.FieldID)
+		for _, f := range fields {
+			if !blogrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blogrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(bruo.modifiers...)
+	_node = &BlogRevision{config: bruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blogrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,24 @@
+package ent
+
+import (
+	"errors"
+	"fmt"
+)
+
+// VersionConflictError is returned when updating an entity that was changed,
+// or deleted, since the version the update expects was read.
+type VersionConflictError struct {
+	Type string
+	ID   any
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("ent: %s %v was changed concurrently", e.Type, e.ID)
+}
+
+// IsVersionConflict returns a boolean indicating whether the error is a
+// version conflict.
+func IsVersionConflict(err error) bool {
+	var e *VersionConflictError
+	return errors.As(err, &e)
+}
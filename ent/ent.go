@@ -8,7 +8,14 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"testMigrationEntgo/ent/auditlog"
 	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/comment"
+	"testMigrationEntgo/ent/follow"
+	"testMigrationEntgo/ent/reaction"
+	"testMigrationEntgo/ent/tag"
+	"testMigrationEntgo/ent/tenant"
 	"testMigrationEntgo/ent/user"
 
 	"entgo.io/ent"
@@ -74,8 +81,15 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			blog.Table: blog.ValidColumn,
-			user.Table: user.ValidColumn,
+			auditlog.Table:     auditlog.ValidColumn,
+			blog.Table:         blog.ValidColumn,
+			blogrevision.Table: blogrevision.ValidColumn,
+			comment.Table:      comment.ValidColumn,
+			follow.Table:       follow.ValidColumn,
+			reaction.Table:     reaction.ValidColumn,
+			tag.Table:          tag.ValidColumn,
+			tenant.Table:       tenant.ValidColumn,
+			user.Table:         user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
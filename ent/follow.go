@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"testMigrationEntgo/ent/follow"
+	"testMigrationEntgo/ent/tenant"
+	"testMigrationEntgo/ent/user"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Follow is the model entity for the Follow schema.
+type Follow struct {
+	config `json:"-"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// FollowedAt holds the value of the "followed_at" field.
+	FollowedAt time.Time `json:"followed_at,omitempty"`
+	// FollowerID holds the value of the "follower_id" field.
+	FollowerID uuid.UUID `json:"follower_id,omitempty"`
+	// FolloweeID holds the value of the "followee_id" field.
+	FolloweeID uuid.UUID `json:"followee_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FollowQuery when eager-loading is set.
+	Edges        FollowEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FollowEdges holds the relations/edges for other nodes in the graph.
+type FollowEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Follower holds the value of the follower edge.
+	Follower *User `json:"follower,omitempty"`
+	// Followee holds the value of the followee edge.
+	Followee *User `json:"followee,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowEdges) TenantOrErr() (*Tenant, error) {
+	if e.loadedTypes[0] {
+		if e.Tenant == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: tenant.Label}
+		}
+		return e.Tenant, nil
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// FollowerOrErr returns the Follower value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowEdges) FollowerOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.Follower == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Follower, nil
+	}
+	return nil, &NotLoadedError{edge: "follower"}
+}
+
+// FolloweeOrErr returns the Followee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowEdges) FolloweeOrErr() (*User, error) {
+	if e.loadedTypes[2] {
+		if e.Followee == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Followee, nil
+	}
+	return nil, &NotLoadedError{edge: "followee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Follow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case follow.FieldFollowedAt:
+			values[i] = new(sql.NullTime)
+		case follow.FieldTenantID, follow.FieldFollowerID, follow.FieldFolloweeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Follow fields.
+func (f *Follow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case follow.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				f.TenantID = *value
+			}
+		case follow.FieldFollowedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field followed_at", values[i])
+			} else if value.Valid {
+				f.FollowedAt = value.Time
+			}
+		case follow.FieldFollowerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field follower_id", values[i])
+			} else if value != nil {
+				f.FollowerID = *value
+			}
+		case follow.FieldFolloweeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field followee_id", values[i])
+			} else if value != nil {
+				f.FolloweeID = *value
+			}
+		default:
+			f.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Follow.
+// This includes values selected through modifiers, order, etc.
+func (f *Follow) Value(name string) (ent.Value, error) {
+	return f.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the Follow entity.
+func (f *Follow) QueryTenant() *TenantQuery {
+	return NewFollowClient(f.config).QueryTenant(f)
+}
+
+// QueryFollower queries the "follower" edge of the Follow entity.
+func (f *Follow) QueryFollower() *UserQuery {
+	return NewFollowClient(f.config).QueryFollower(f)
+}
+
+// QueryFollowee queries the "followee" edge of the Follow entity.
+func (f *Follow) QueryFollowee() *UserQuery {
+	return NewFollowClient(f.config).QueryFollowee(f)
+}
+
+// Update returns a builder for updating this Follow.
+// Note that you need to call Follow.Unwrap() before calling this method if this Follow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (f *Follow) Update() *FollowUpdateOne {
+	return NewFollowClient(f.config).UpdateOne(f)
+}
+
+// Unwrap unwraps the Follow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (f *Follow) Unwrap() *Follow {
+	_tx, ok := f.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Follow is not a transactional entity")
+	}
+	f.config.driver = _tx.drv
+	return f
+}
+
+// String implements the fmt.Stringer.
+func (f *Follow) String() string {
+	var builder strings.Builder
+	builder.WriteString("Follow(")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", f.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("followed_at=")
+	builder.WriteString(f.FollowedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("follower_id=")
+	builder.WriteString(fmt.Sprintf("%v", f.FollowerID))
+	builder.WriteString(", ")
+	builder.WriteString("followee_id=")
+	builder.WriteString(fmt.Sprintf("%v", f.FolloweeID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Follows is a parsable slice of Follow.
+type Follows []*Follow
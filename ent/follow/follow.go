@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package follow
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the follow type in the database.
+	Label = "follow"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldFollowedAt holds the string denoting the followed_at field in the database.
+	FieldFollowedAt = "followed_at"
+	// FieldFollowerID holds the string denoting the follower_id field in the database.
+	FieldFollowerID = "follower_id"
+	// FieldFolloweeID holds the string denoting the followee_id field in the database.
+	FieldFolloweeID = "followee_id"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeFollower holds the string denoting the follower edge name in mutations.
+	EdgeFollower = "follower"
+	// EdgeFollowee holds the string denoting the followee edge name in mutations.
+	EdgeFollowee = "followee"
+	// TenantFieldID holds the string denoting the ID field of the Tenant.
+	TenantFieldID = "id"
+	// UserFieldID holds the string denoting the ID field of the User.
+	UserFieldID = "id"
+	// Table holds the table name of the follow in the database.
+	Table = "follows"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "follows"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_id"
+	// FollowerTable is the table that holds the follower relation/edge.
+	FollowerTable = "follows"
+	// FollowerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	FollowerInverseTable = "users"
+	// FollowerColumn is the table column denoting the follower relation/edge.
+	FollowerColumn = "follower_id"
+	// FolloweeTable is the table that holds the followee relation/edge.
+	FolloweeTable = "follows"
+	// FolloweeInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	FolloweeInverseTable = "users"
+	// FolloweeColumn is the table column denoting the followee relation/edge.
+	FolloweeColumn = "followee_id"
+)
+
+// Columns holds all SQL columns for follow fields.
+var Columns = []string{
+	FieldTenantID,
+	FieldFollowedAt,
+	FieldFollowerID,
+	FieldFolloweeID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "testMigrationEntgo/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultFollowedAt holds the default value on creation for the "followed_at" field.
+	DefaultFollowedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Follow queries.
+type OrderOption func(*sql.Selector)
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByFollowedAt orders the results by the followed_at field.
+func ByFollowedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowedAt, opts...).ToFunc()
+}
+
+// ByFollowerID orders the results by the follower_id field.
+func ByFollowerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowerID, opts...).ToFunc()
+}
+
+// ByFolloweeID orders the results by the followee_id field.
+func ByFolloweeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFolloweeID, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFollowerField orders the results by follower field.
+func ByFollowerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFolloweeField orders the results by followee field.
+func ByFolloweeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFolloweeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, TenantColumn),
+		sqlgraph.To(TenantInverseTable, TenantFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TenantTable, TenantColumn),
+	)
+}
+func newFollowerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FollowerColumn),
+		sqlgraph.To(FollowerInverseTable, UserFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, FollowerTable, FollowerColumn),
+	)
+}
+func newFolloweeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FolloweeColumn),
+		sqlgraph.To(FolloweeInverseTable, UserFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, FolloweeTable, FolloweeColumn),
+	)
+}
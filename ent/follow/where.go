@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package follow
+
+import (
+	"testMigrationEntgo/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldTenantID, v))
+}
+
+// FollowedAt applies equality check predicate on the "followed_at" field. It's identical to FollowedAtEQ.
+func FollowedAt(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFollowedAt, v))
+}
+
+// FollowerID applies equality check predicate on the "follower_id" field. It's identical to FollowerIDEQ.
+func FollowerID(v uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFollowerID, v))
+}
+
+// FolloweeID applies equality check predicate on the "followee_id" field. It's identical to FolloweeIDEQ.
+func FolloweeID(v uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFolloweeID, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// FollowedAtEQ applies the EQ predicate on the "followed_at" field.
+func FollowedAtEQ(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFollowedAt, v))
+}
+
+// FollowedAtNEQ applies the NEQ predicate on the "followed_at" field.
+func FollowedAtNEQ(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldFollowedAt, v))
+}
+
+// FollowedAtIn applies the In predicate on the "followed_at" field.
+func FollowedAtIn(vs ...time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldFollowedAt, vs...))
+}
+
+// FollowedAtNotIn applies the NotIn predicate on the "followed_at" field.
+func FollowedAtNotIn(vs ...time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldFollowedAt, vs...))
+}
+
+// FollowedAtGT applies the GT predicate on the "followed_at" field.
+func FollowedAtGT(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldGT(FieldFollowedAt, v))
+}
+
+// FollowedAtGTE applies the GTE predicate on the "followed_at" field.
+func FollowedAtGTE(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldGTE(FieldFollowedAt, v))
+}
+
+// FollowedAtLT applies the LT predicate on the "followed_at" field.
+func FollowedAtLT(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldLT(FieldFollowedAt, v))
+}
+
+// FollowedAtLTE applies the LTE predicate on the "followed_at" field.
+func FollowedAtLTE(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldLTE(FieldFollowedAt, v))
+}
+
+// FollowerIDEQ applies the EQ predicate on the "follower_id" field.
+func FollowerIDEQ(v uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFollowerID, v))
+}
+
+// FollowerIDNEQ applies the NEQ predicate on the "follower_id" field.
+func FollowerIDNEQ(v uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldFollowerID, v))
+}
+
+// FollowerIDIn applies the In predicate on the "follower_id" field.
+func FollowerIDIn(vs ...uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldFollowerID, vs...))
+}
+
+// FollowerIDNotIn applies the NotIn predicate on the "follower_id" field.
+func FollowerIDNotIn(vs ...uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldFollowerID, vs...))
+}
+
+// FolloweeIDEQ applies the EQ predicate on the "followee_id" field.
+func FolloweeIDEQ(v uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFolloweeID, v))
+}
+
+// FolloweeIDNEQ applies the NEQ predicate on the "followee_id" field.
+func FolloweeIDNEQ(v uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldFolloweeID, v))
+}
+
+// FolloweeIDIn applies the In predicate on the "followee_id" field.
+func FolloweeIDIn(vs ...uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldFolloweeID, vs...))
+}
+
+// FolloweeIDNotIn applies the NotIn predicate on the "followee_id" field.
+func FolloweeIDNotIn(vs ...uuid.UUID) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldFolloweeID, vs...))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, TenantColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFollower applies the HasEdge predicate on the "follower" edge.
+func HasFollower() predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FollowerColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, FollowerTable, FollowerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFollowerWith applies the HasEdge predicate on the "follower" edge with a given conditions (other predicates).
+func HasFollowerWith(preds ...predicate.User) predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := newFollowerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFollowee applies the HasEdge predicate on the "followee" edge.
+func HasFollowee() predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FolloweeColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, FolloweeTable, FolloweeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFolloweeWith applies the HasEdge predicate on the "followee" edge with a given conditions (other predicates).
+func HasFolloweeWith(preds ...predicate.User) predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := newFolloweeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Follow) predicate.Follow {
+	return predicate.Follow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Follow) predicate.Follow {
+	return predicate.Follow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Follow) predicate.Follow {
+	return predicate.Follow(sql.NotPredicates(p))
+}
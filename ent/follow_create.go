@@ -0,0 +1,547 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testMigrationEntgo/ent/follow"
+	"testMigrationEntgo/ent/tenant"
+	"testMigrationEntgo/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// FollowCreate is the builder for creating a Follow entity.
+type FollowCreate struct {
+	config
+	mutation *FollowMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (fc *FollowCreate) SetTenantID(u uuid.UUID) *FollowCreate {
+	fc.mutation.SetTenantID(u)
+	return fc
+}
+
+// SetFollowedAt sets the "followed_at" field.
+func (fc *FollowCreate) SetFollowedAt(t time.Time) *FollowCreate {
+	fc.mutation.SetFollowedAt(t)
+	return fc
+}
+
+// SetNillableFollowedAt sets the "followed_at" field if the given value is not nil.
+func (fc *FollowCreate) SetNillableFollowedAt(t *time.Time) *FollowCreate {
+	if t != nil {
+		fc.SetFollowedAt(*t)
+	}
+	return fc
+}
+
+// SetFollowerID sets the "follower_id" field.
+func (fc *FollowCreate) SetFollowerID(u uuid.UUID) *FollowCreate {
+	fc.mutation.SetFollowerID(u)
+	return fc
+}
+
+// SetFolloweeID sets the "followee_id" field.
+func (fc *FollowCreate) SetFolloweeID(u uuid.UUID) *FollowCreate {
+	fc.mutation.SetFolloweeID(u)
+	return fc
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (fc *FollowCreate) SetTenant(t *Tenant) *FollowCreate {
+	return fc.SetTenantID(t.ID)
+}
+
+// SetFollower sets the "follower" edge to the User entity.
+func (fc *FollowCreate) SetFollower(u *User) *FollowCreate {
+	return fc.SetFollowerID(u.ID)
+}
+
+// SetFollowee sets the "followee" edge to the User entity.
+func (fc *FollowCreate) SetFollowee(u *User) *FollowCreate {
+	return fc.SetFolloweeID(u.ID)
+}
+
+// Mutation returns the FollowMutation object of the builder.
+func (fc *FollowCreate) Mutation() *FollowMutation {
+	return fc.mutation
+}
+
+// Save creates the Follow in the database.
+func (fc *FollowCreate) Save(ctx context.Context) (*Follow, error) {
+	if err := fc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, fc.sqlSave, fc.mutation, fc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fc *FollowCreate) SaveX(ctx context.Context) *Follow {
+	v, err := fc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fc *FollowCreate) Exec(ctx context.Context) error {
+	_, err := fc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fc *FollowCreate) ExecX(ctx context.Context) {
+	if err := fc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fc *FollowCreate) defaults() error {
+	if _, ok := fc.mutation.FollowedAt(); !ok {
+		if follow.DefaultFollowedAt == nil {
+			return fmt.Errorf("ent: uninitialized follow.DefaultFollowedAt (forgotten import ent/runtime?)")
+		}
+		v := follow.DefaultFollowedAt()
+		fc.mutation.SetFollowedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fc *FollowCreate) check() error {
+	if _, ok := fc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Follow.tenant_id"`)}
+	}
+	if _, ok := fc.mutation.FollowedAt(); !ok {
+		return &ValidationError{Name: "followed_at", err: errors.New(`ent: missing required field "Follow.followed_at"`)}
+	}
+	if _, ok := fc.mutation.FollowerID(); !ok {
+		return &ValidationError{Name: "follower_id", err: errors.New(`ent: missing required field "Follow.follower_id"`)}
+	}
+	if _, ok := fc.mutation.FolloweeID(); !ok {
+		return &ValidationError{Name: "followee_id", err: errors.New(`ent: missing required field "Follow.followee_id"`)}
+	}
+	if _, ok := fc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "Follow.tenant"`)}
+	}
+	if _, ok := fc.mutation.FollowerID(); !ok {
+		return &ValidationError{Name: "follower", err: errors.New(`ent: missing required edge "Follow.follower"`)}
+	}
+	if _, ok := fc.mutation.FolloweeID(); !ok {
+		return &ValidationError{Name: "followee", err: errors.New(`ent: missing required edge "Follow.followee"`)}
+	}
+	return nil
+}
+
+func (fc *FollowCreate) sqlSave(ctx context.Context) (*Follow, error) {
+	if err := fc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (fc *FollowCreate) createSpec() (*Follow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Follow{config: fc.config}
+		_spec = sqlgraph.NewCreateSpec(follow.Table, nil)
+	)
+	_spec.OnConflict = fc.conflict
+	if value, ok := fc.mutation.FollowedAt(); ok {
+		_spec.SetField(follow.FieldFollowedAt, field.TypeTime, value)
+		_node.FollowedAt = value
+	}
+	if nodes := fc.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   follow.TenantTable,
+			Columns: []string{follow.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.FollowerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   follow.FollowerTable,
+			Columns: []string{follow.FollowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FollowerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.FolloweeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   follow.FolloweeTable,
+			Columns: []string{follow.FolloweeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FolloweeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Follow.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FollowUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (fc *FollowCreate) OnConflict(opts ...sql.ConflictOption) *FollowUpsertOne {
+	fc.conflict = opts
+	return &FollowUpsertOne{
+		create: fc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fc *FollowCreate) OnConflictColumns(columns ...string) *FollowUpsertOne {
+	fc.conflict = append(fc.conflict, sql.ConflictColumns(columns...))
+	return &FollowUpsertOne{
+		create: fc,
+	}
+}
+
+type (
+	// FollowUpsertOne is the builder for "upsert"-ing
+	//  one Follow node.
+	FollowUpsertOne struct {
+		create *FollowCreate
+	}
+
+	// FollowUpsert is the "OnConflict" setter.
+	FollowUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FollowUpsertOne) UpdateNewValues() *FollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(follow.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.FollowedAt(); exists {
+			s.SetIgnore(follow.FieldFollowedAt)
+		}
+		if _, exists := u.create.mutation.FollowerID(); exists {
+			s.SetIgnore(follow.FieldFollowerID)
+		}
+		if _, exists := u.create.mutation.FolloweeID(); exists {
+			s.SetIgnore(follow.FieldFolloweeID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FollowUpsertOne) Ignore() *FollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FollowUpsertOne) DoNothing() *FollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FollowCreate.OnConflict
+// documentation for more info.
+func (u *FollowUpsertOne) Update(set func(*FollowUpsert)) *FollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FollowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *FollowUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FollowCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FollowUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// FollowCreateBulk is the builder for creating many Follow entities in bulk.
+type FollowCreateBulk struct {
+	config
+	err      error
+	builders []*FollowCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Follow entities in the database.
+func (fcb *FollowCreateBulk) Save(ctx context.Context) ([]*Follow, error) {
+	if fcb.err != nil {
+		return nil, fcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
+	nodes := make([]*Follow, len(fcb.builders))
+	mutators := make([]Mutator, len(fcb.builders))
+	for i := range fcb.builders {
+		func(i int, root context.Context) {
+			builder := fcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FollowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = fcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcb *FollowCreateBulk) SaveX(ctx context.Context) []*Follow {
+	v, err := fcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fcb *FollowCreateBulk) Exec(ctx context.Context) error {
+	_, err := fcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcb *FollowCreateBulk) ExecX(ctx context.Context) {
+	if err := fcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Follow.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FollowUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (fcb *FollowCreateBulk) OnConflict(opts ...sql.ConflictOption) *FollowUpsertBulk {
+	fcb.conflict = opts
+	return &FollowUpsertBulk{
+		create: fcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fcb *FollowCreateBulk) OnConflictColumns(columns ...string) *FollowUpsertBulk {
+	fcb.conflict = append(fcb.conflict, sql.ConflictColumns(columns...))
+	return &FollowUpsertBulk{
+		create: fcb,
+	}
+}
+
+// FollowUpsertBulk is the builder for "upsert"-ing
+// a bulk of Follow nodes.
+type FollowUpsertBulk struct {
+	create *FollowCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FollowUpsertBulk) UpdateNewValues() *FollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(follow.FieldTenantID)
+			}
+			if _, exists := b.mutation.FollowedAt(); exists {
+				s.SetIgnore(follow.FieldFollowedAt)
+			}
+			if _, exists := b.mutation.FollowerID(); exists {
+				s.SetIgnore(follow.FieldFollowerID)
+			}
+			if _, exists := b.mutation.FolloweeID(); exists {
+				s.SetIgnore(follow.FieldFolloweeID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FollowUpsertBulk) Ignore() *FollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FollowUpsertBulk) DoNothing() *FollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FollowCreateBulk.OnConflict
+// documentation for more info.
+func (u *FollowUpsertBulk) Update(set func(*FollowUpsert)) *FollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FollowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *FollowUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FollowCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FollowCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FollowUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
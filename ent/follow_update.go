@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testMigrationEntgo/ent/follow"
+	"testMigrationEntgo/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FollowUpdate is the builder for updating Follow entities.
+type FollowUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FollowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FollowUpdate builder.
+func (fu *FollowUpdate) Where(ps ...predicate.Follow) *FollowUpdate {
+	fu.mutation.Where(ps...)
+	return fu
+}
+
+// Mutation returns the FollowMutation object of the builder.
+func (fu *FollowUpdate) Mutation() *FollowMutation {
+	return fu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fu *FollowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, fu.sqlSave, fu.mutation, fu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fu *FollowUpdate) SaveX(ctx context.Context) int {
+	affected, err := fu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fu *FollowUpdate) Exec(ctx context.Context) error {
+	_, err := fu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fu *FollowUpdate) ExecX(ctx context.Context) {
+	if err := fu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fu *FollowUpdate) check() error {
+	if _, ok := fu.mutation.TenantID(); fu.mutation.TenantCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Follow.tenant"`)
+	}
+	if _, ok := fu.mutation.FollowerID(); fu.mutation.FollowerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Follow.follower"`)
+	}
+	if _, ok := fu.mutation.FolloweeID(); fu.mutation.FolloweeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Follow.followee"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fu *FollowUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FollowUpdate {
+	fu.modifiers = append(fu.modifiers, modifiers...)
+	return fu
+}
+
+func (fu *FollowUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(follow.Table, follow.Columns, sqlgraph.NewFieldSpec(follow.FieldFollowerID, field.TypeUUID), sqlgraph.NewFieldSpec(follow.FieldFolloweeID, field.TypeUUID))
+	if ps := fu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(fu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{follow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fu.mutation.done = true
+	return n, nil
+}
+
+// FollowUpdateOne is the builder for updating a single Follow entity.
+type FollowUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FollowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the FollowMutation object of the builder.
+func (fuo *FollowUpdateOne) Mutation() *FollowMutation {
+	return fuo.mutation
+}
+
+// Where appends a list predicates to the FollowUpdate builder.
+func (fuo *FollowUpdateOne) Where(ps ...predicate.Follow) *FollowUpdateOne {
+	fuo.mutation.Where(ps...)
+	return fuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fuo *FollowUpdateOne) Select(field string, fields ...string) *FollowUpdateOne {
+	fuo.fields = append([]string{field}, fields...)
+	return fuo
+}
+
+// Save executes the query and returns the updated Follow entity.
+func (fuo *FollowUpdateOne) Save(ctx context.Context) (*Follow, error) {
+	return withHooks(ctx, fuo.sqlSave, fuo.mutation, fuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fuo *FollowUpdateOne) SaveX(ctx context.Context) *Follow {
+	node, err := fuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fuo *FollowUpdateOne) Exec(ctx context.Context) error {
+	_, err := fuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fuo *FollowUpdateOne) ExecX(ctx context.Context) {
+	if err := fuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fuo *FollowUpdateOne) check() error {
+	if _, ok := fuo.mutation.TenantID(); fuo.mutation.TenantCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Follow.tenant"`)
+	}
+	if _, ok := fuo.mutation.FollowerID(); fuo.mutation.FollowerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Follow.follower"`)
+	}
+	if _, ok := fuo.mutation.FolloweeID(); fuo.mutation.FolloweeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Follow.followee"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fuo *FollowUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FollowUpdateOne {
+	fuo.modifiers = append(fuo.modifiers, modifiers...)
+	return fuo
+}
+
+func (fuo *FollowUpdateOne) sqlSave(ctx context.Context) (_node *Follow, err error) {
+	if err := fuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(follow.Table, follow.Columns, sqlgraph.NewFieldSpec(follow.FieldFollowerID, field.TypeUUID), sqlgraph.NewFieldSpec(follow.FieldFolloweeID, field.TypeUUID))
+	if id, ok := fuo.mutation.FollowerID(); !ok {
+		return nil, &ValidationError{Name: "follower_id", err: errors.New(`ent: missing "Follow.follower_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := fuo.mutation.FolloweeID(); !ok {
+		return nil, &ValidationError{Name: "followee_id", err: errors.New(`ent: missing "Follow.followee_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := fuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !follow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := fuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(fuo.modifiers...)
+	_node = &Follow{config: fuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{follow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fuo.mutation.done = true
+	return _node, nil
+}
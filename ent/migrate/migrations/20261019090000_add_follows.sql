@@ -0,0 +1,7 @@
+-- Rename "followers" column of "users" table, the existing counts are kept
+-- as the baseline of the follower count derived from the "follows" table
+ALTER TABLE "users" RENAME COLUMN "followers" TO "legacy_followers";
+-- Create "follows" table
+CREATE TABLE "follows" ("followed_at" timestamptz NOT NULL, "follower_id" bigint NOT NULL, "followee_id" bigint NOT NULL, PRIMARY KEY ("follower_id", "followee_id"), CONSTRAINT "follows_users_followee" FOREIGN KEY ("followee_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "follows_users_follower" FOREIGN KEY ("follower_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "follow_followee_id" to table: "follows"
+CREATE INDEX "follow_followee_id" ON "follows" ("followee_id");
@@ -0,0 +1,4 @@
+-- Modify "blogs" table
+ALTER TABLE "blogs" ADD COLUMN "status" character varying NOT NULL DEFAULT 'draft', ADD COLUMN "published_at" timestamptz NULL, ADD COLUMN "archived_at" timestamptz NULL;
+-- Existing blogs were all visible, so they are considered published since their creation
+UPDATE "blogs" SET "status" = 'published', "published_at" = "created_at";
@@ -0,0 +1,4 @@
+-- Modify "blogs" table
+ALTER TABLE "blogs" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "deleted_at" timestamptz NULL;
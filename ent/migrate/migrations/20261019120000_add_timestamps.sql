@@ -0,0 +1,11 @@
+-- Modify "blogs" table
+ALTER TABLE "blogs" ADD COLUMN "updated_at" timestamptz NULL;
+-- Backfill "updated_at" of "blogs" with the latest known change
+UPDATE "blogs" SET "updated_at" = GREATEST("created_at", "published_at", "archived_at", "deleted_at");
+ALTER TABLE "blogs" ALTER COLUMN "updated_at" SET NOT NULL;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "created_at" timestamptz NULL, ADD COLUMN "updated_at" timestamptz NULL;
+-- Backfill "created_at" of "users" with their first blog post, if any
+UPDATE "users" SET "created_at" = COALESCE((SELECT MIN("blogs"."created_at") FROM "blogs" WHERE "blogs"."user_blog_posts" = "users"."id"), now());
+UPDATE "users" SET "updated_at" = GREATEST("created_at", "deleted_at");
+ALTER TABLE "users" ALTER COLUMN "created_at" SET NOT NULL, ALTER COLUMN "updated_at" SET NOT NULL;
@@ -0,0 +1,4 @@
+-- Create "audit_logs" table
+CREATE TABLE "audit_logs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "entity_type" character varying NOT NULL, "entity_id" bigint NOT NULL, "op" character varying NOT NULL, "changes" jsonb NULL, "actor" character varying NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "auditlog_entity_type_entity_id" to table: "audit_logs"
+CREATE INDEX "auditlog_entity_type_entity_id" ON "audit_logs" ("entity_type", "entity_id");
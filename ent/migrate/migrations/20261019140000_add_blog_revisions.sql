@@ -0,0 +1,6 @@
+-- Create "blog_revisions" table
+CREATE TABLE "blog_revisions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "number" bigint NOT NULL, "title" character varying NOT NULL, "body" text NOT NULL, "created_at" timestamptz NOT NULL, "blog_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "blog_revisions_blogs_revisions" FOREIGN KEY ("blog_id") REFERENCES "blogs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "blogrevision_blog_id_number" to table: "blog_revisions"
+CREATE UNIQUE INDEX "blogrevision_blog_id_number" ON "blog_revisions" ("blog_id", "number");
+-- Store the current title and body of existing blogs as their first revision
+INSERT INTO "blog_revisions" ("number", "title", "body", "created_at", "blog_id") SELECT 1, "title", "body", "updated_at", "id" FROM "blogs";
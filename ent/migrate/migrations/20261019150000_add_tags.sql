@@ -0,0 +1,6 @@
+-- Create "tags" table
+CREATE TABLE "tags" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying(64) NOT NULL, PRIMARY KEY ("id"));
+-- Create index "tags_name_key" to table: "tags"
+CREATE UNIQUE INDEX "tags_name_key" ON "tags" ("name");
+-- Create "blog_tags" table
+CREATE TABLE "blog_tags" ("blog_id" bigint NOT NULL, "tag_id" bigint NOT NULL, PRIMARY KEY ("blog_id", "tag_id"), CONSTRAINT "blog_tags_blog_id" FOREIGN KEY ("blog_id") REFERENCES "blogs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "blog_tags_tag_id" FOREIGN KEY ("tag_id") REFERENCES "tags" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
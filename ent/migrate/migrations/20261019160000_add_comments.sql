@@ -0,0 +1,4 @@
+-- Create "comments" table
+CREATE TABLE "comments" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "body" text NOT NULL, "blog_id" bigint NOT NULL, "parent_id" bigint NULL, "author_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "comments_blogs_comments" FOREIGN KEY ("blog_id") REFERENCES "blogs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "comments_comments_replies" FOREIGN KEY ("parent_id") REFERENCES "comments" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "comments_users_comments" FOREIGN KEY ("author_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "comment_blog_id" to table: "comments"
+CREATE INDEX "comment_blog_id" ON "comments" ("blog_id");
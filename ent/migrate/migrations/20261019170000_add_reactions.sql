@@ -0,0 +1,6 @@
+-- Create "reactions" table
+CREATE TABLE "reactions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "kind" character varying NOT NULL, "created_at" timestamptz NOT NULL, "blog_id" bigint NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "reactions_blogs_reactions" FOREIGN KEY ("blog_id") REFERENCES "blogs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "reactions_users_reactions" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "reaction_user_id_blog_id_kind" to table: "reactions"
+CREATE UNIQUE INDEX "reaction_user_id_blog_id_kind" ON "reactions" ("user_id", "blog_id", "kind");
+-- Create index "reaction_blog_id_kind" to table: "reactions"
+CREATE INDEX "reaction_blog_id_kind" ON "reactions" ("blog_id", "kind");
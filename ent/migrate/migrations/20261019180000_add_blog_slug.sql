@@ -0,0 +1,27 @@
+-- Modify "blogs" table
+ALTER TABLE "blogs" ADD COLUMN "slug" character varying(100) NULL;
+-- Backfill "slug" of existing blogs from their title in creation order, adding the lowest numeric suffix that is free among the slugs of the author, as the hook does.
+-- Unlike the hook, letters with diacritics are dropped rather than transliterated.
+CREATE INDEX "blogs_slug_backfill" ON "blogs" ("user_blog_posts", "slug");
+DO $$
+DECLARE
+  "b" record;
+  "base" text;
+  "candidate" text;
+  "n" integer;
+BEGIN
+  FOR "b" IN SELECT "id", "user_blog_posts", "title" FROM "blogs" ORDER BY "id" LOOP
+    "base" := COALESCE(NULLIF(trim(BOTH '-' FROM left(regexp_replace(lower("b"."title"), '[^a-z0-9]+', '-', 'g'), 80)), ''), 'post');
+    "candidate" := "base";
+    "n" := 1;
+    WHILE EXISTS (SELECT 1 FROM "blogs" WHERE "blogs"."user_blog_posts" IS NOT DISTINCT FROM "b"."user_blog_posts" AND "blogs"."slug" = "candidate") LOOP
+      "n" := "n" + 1;
+      "candidate" := "base" || '-' || "n";
+    END LOOP;
+    UPDATE "blogs" SET "slug" = "candidate" WHERE "blogs"."id" = "b"."id";
+  END LOOP;
+END $$;
+DROP INDEX "blogs_slug_backfill";
+ALTER TABLE "blogs" ALTER COLUMN "slug" SET NOT NULL;
+-- Create index "blog_slug_user_blog_posts" to table: "blogs"
+CREATE UNIQUE INDEX "blog_slug_user_blog_posts" ON "blogs" ("slug", "user_blog_posts");
@@ -0,0 +1,5 @@
+-- Add the full-text search column of "blogs", generated from the title and body, with matches in the title ranked higher.
+-- The column is maintained by postgres and is not part of the ent schema.
+ALTER TABLE "blogs" ADD COLUMN "search" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english', "title"), 'A') || setweight(to_tsvector('english', "body"), 'B')) STORED;
+-- Create index "blog_search" to table: "blogs"
+CREATE INDEX "blog_search" ON "blogs" USING GIN ("search");
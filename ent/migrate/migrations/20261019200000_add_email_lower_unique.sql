@@ -0,0 +1,18 @@
+-- Refuse to go on while emails differing only by case exist, listing them, as merging users can't be decided here.
+-- The check-emails command lists the same collisions, along with invalid emails, before migrating.
+DO $$
+DECLARE
+  collisions text;
+BEGIN
+  SELECT string_agg(format('%s (ids %s)', "email", "ids"), ', ') INTO collisions
+  FROM (SELECT lower(trim("email")) AS "email", string_agg("id"::text, ', ' ORDER BY "id") AS "ids" FROM "users" GROUP BY 1 HAVING count(*) > 1) AS "c";
+  IF collisions IS NOT NULL THEN
+    RAISE EXCEPTION 'users with emails differing only by case: %', collisions
+      USING HINT = 'merge or rename these users, then run the migration again';
+  END IF;
+END
+$$;
+-- Normalize the emails of existing users as the hook does
+UPDATE "users" SET "email" = lower(trim("email")) WHERE "email" <> lower(trim("email"));
+-- Create index "users_email_lower_key" to table: "users"
+CREATE UNIQUE INDEX "users_email_lower_key" ON "users" (lower("email"));
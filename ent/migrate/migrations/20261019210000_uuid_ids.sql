@@ -0,0 +1,68 @@
+-- Generate UUIDv7 ids for existing users and blogs, timestamped with their creation time so that they sort by it like the bigint ids did.
+-- The function lives in the session only.
+CREATE FUNCTION pg_temp.uuid_v7("ts" timestamptz) RETURNS uuid LANGUAGE sql VOLATILE AS $$ SELECT encode(set_bit(set_bit(overlay(uuid_send(gen_random_uuid()) PLACING substring(int8send(floor(extract(epoch FROM "ts") * 1000)::bigint) FROM 3) FROM 1 FOR 6), 52, 1), 53, 1), 'hex')::uuid $$;
+ALTER TABLE "users" ADD COLUMN "uuid" uuid NULL;
+UPDATE "users" SET "uuid" = pg_temp.uuid_v7("created_at");
+ALTER TABLE "blogs" ADD COLUMN "uuid" uuid NULL;
+UPDATE "blogs" SET "uuid" = pg_temp.uuid_v7("created_at");
+-- Rewrite the columns referring to users and blogs, which drops their foreign keys and indexes
+ALTER TABLE "blogs" ADD COLUMN "user_blog_posts_uuid" uuid NULL;
+UPDATE "blogs" SET "user_blog_posts_uuid" = "users"."uuid" FROM "users" WHERE "blogs"."user_blog_posts" = "users"."id";
+ALTER TABLE "blogs" DROP COLUMN "user_blog_posts";
+ALTER TABLE "blogs" RENAME COLUMN "user_blog_posts_uuid" TO "user_blog_posts";
+ALTER TABLE "blog_revisions" ADD COLUMN "blog_id_uuid" uuid NULL;
+UPDATE "blog_revisions" SET "blog_id_uuid" = "blogs"."uuid" FROM "blogs" WHERE "blog_revisions"."blog_id" = "blogs"."id";
+ALTER TABLE "blog_revisions" DROP COLUMN "blog_id";
+ALTER TABLE "blog_revisions" RENAME COLUMN "blog_id_uuid" TO "blog_id";
+ALTER TABLE "blog_tags" ADD COLUMN "blog_id_uuid" uuid NULL;
+UPDATE "blog_tags" SET "blog_id_uuid" = "blogs"."uuid" FROM "blogs" WHERE "blog_tags"."blog_id" = "blogs"."id";
+ALTER TABLE "blog_tags" DROP COLUMN "blog_id";
+ALTER TABLE "blog_tags" RENAME COLUMN "blog_id_uuid" TO "blog_id";
+ALTER TABLE "comments" ADD COLUMN "blog_id_uuid" uuid NULL;
+UPDATE "comments" SET "blog_id_uuid" = "blogs"."uuid" FROM "blogs" WHERE "comments"."blog_id" = "blogs"."id";
+ALTER TABLE "comments" DROP COLUMN "blog_id";
+ALTER TABLE "comments" RENAME COLUMN "blog_id_uuid" TO "blog_id";
+ALTER TABLE "comments" ADD COLUMN "author_id_uuid" uuid NULL;
+UPDATE "comments" SET "author_id_uuid" = "users"."uuid" FROM "users" WHERE "comments"."author_id" = "users"."id";
+ALTER TABLE "comments" DROP COLUMN "author_id";
+ALTER TABLE "comments" RENAME COLUMN "author_id_uuid" TO "author_id";
+ALTER TABLE "follows" ADD COLUMN "follower_id_uuid" uuid NULL;
+UPDATE "follows" SET "follower_id_uuid" = "users"."uuid" FROM "users" WHERE "follows"."follower_id" = "users"."id";
+ALTER TABLE "follows" DROP COLUMN "follower_id";
+ALTER TABLE "follows" RENAME COLUMN "follower_id_uuid" TO "follower_id";
+ALTER TABLE "follows" ADD COLUMN "followee_id_uuid" uuid NULL;
+UPDATE "follows" SET "followee_id_uuid" = "users"."uuid" FROM "users" WHERE "follows"."followee_id" = "users"."id";
+ALTER TABLE "follows" DROP COLUMN "followee_id";
+ALTER TABLE "follows" RENAME COLUMN "followee_id_uuid" TO "followee_id";
+ALTER TABLE "reactions" ADD COLUMN "blog_id_uuid" uuid NULL;
+UPDATE "reactions" SET "blog_id_uuid" = "blogs"."uuid" FROM "blogs" WHERE "reactions"."blog_id" = "blogs"."id";
+ALTER TABLE "reactions" DROP COLUMN "blog_id";
+ALTER TABLE "reactions" RENAME COLUMN "blog_id_uuid" TO "blog_id";
+ALTER TABLE "reactions" ADD COLUMN "user_id_uuid" uuid NULL;
+UPDATE "reactions" SET "user_id_uuid" = "users"."uuid" FROM "users" WHERE "reactions"."user_id" = "users"."id";
+ALTER TABLE "reactions" DROP COLUMN "user_id";
+ALTER TABLE "reactions" RENAME COLUMN "user_id_uuid" TO "user_id";
+-- Audit logs refer to entities of any type, their ids are now stored as text
+ALTER TABLE "audit_logs" ALTER COLUMN "entity_id" TYPE character varying USING "entity_id"::text;
+UPDATE "audit_logs" SET "entity_id" = "users"."uuid"::text FROM "users" WHERE "audit_logs"."entity_type" = 'User' AND "audit_logs"."entity_id" = "users"."id"::text;
+UPDATE "audit_logs" SET "entity_id" = "blogs"."uuid"::text FROM "blogs" WHERE "audit_logs"."entity_type" = 'Blog' AND "audit_logs"."entity_id" = "blogs"."id"::text;
+-- Swap the primary keys of "users" and "blogs"
+ALTER TABLE "users" DROP COLUMN "id";
+ALTER TABLE "users" RENAME COLUMN "uuid" TO "id";
+ALTER TABLE "users" ALTER COLUMN "id" SET NOT NULL, ADD PRIMARY KEY ("id");
+ALTER TABLE "blogs" DROP COLUMN "id";
+ALTER TABLE "blogs" RENAME COLUMN "uuid" TO "id";
+ALTER TABLE "blogs" ALTER COLUMN "id" SET NOT NULL, ADD PRIMARY KEY ("id");
+-- Restore the constraints and indexes of the rewritten columns
+ALTER TABLE "blogs" ADD CONSTRAINT "blogs_users_blog_posts" FOREIGN KEY ("user_blog_posts") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+CREATE UNIQUE INDEX "blog_slug_user_blog_posts" ON "blogs" ("slug", "user_blog_posts");
+ALTER TABLE "blog_revisions" ALTER COLUMN "blog_id" SET NOT NULL, ADD CONSTRAINT "blog_revisions_blogs_revisions" FOREIGN KEY ("blog_id") REFERENCES "blogs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+CREATE UNIQUE INDEX "blogrevision_blog_id_number" ON "blog_revisions" ("blog_id", "number");
+ALTER TABLE "blog_tags" ALTER COLUMN "blog_id" SET NOT NULL, ADD PRIMARY KEY ("blog_id", "tag_id"), ADD CONSTRAINT "blog_tags_blog_id" FOREIGN KEY ("blog_id") REFERENCES "blogs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "comments" ALTER COLUMN "blog_id" SET NOT NULL, ADD CONSTRAINT "comments_blogs_comments" FOREIGN KEY ("blog_id") REFERENCES "blogs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "comments_users_comments" FOREIGN KEY ("author_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+CREATE INDEX "comment_blog_id" ON "comments" ("blog_id");
+ALTER TABLE "follows" ALTER COLUMN "follower_id" SET NOT NULL, ALTER COLUMN "followee_id" SET NOT NULL, ADD PRIMARY KEY ("follower_id", "followee_id"), ADD CONSTRAINT "follows_users_follower" FOREIGN KEY ("follower_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "follows_users_followee" FOREIGN KEY ("followee_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+CREATE INDEX "follow_followee_id" ON "follows" ("followee_id");
+ALTER TABLE "reactions" ALTER COLUMN "blog_id" SET NOT NULL, ALTER COLUMN "user_id" SET NOT NULL, ADD CONSTRAINT "reactions_blogs_reactions" FOREIGN KEY ("blog_id") REFERENCES "blogs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "reactions_users_reactions" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+CREATE UNIQUE INDEX "reaction_user_id_blog_id_kind" ON "reactions" ("user_id", "blog_id", "kind");
+CREATE INDEX "reaction_blog_id_kind" ON "reactions" ("blog_id", "kind");
@@ -0,0 +1,4 @@
+-- Modify "blogs" table
+ALTER TABLE "blogs" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,18 @@
+-- Create "tenants" table
+CREATE TABLE "tenants" ("id" uuid NOT NULL, "name" character varying NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "tenants_name_key" to table: "tenants"
+CREATE UNIQUE INDEX "tenants_name_key" ON "tenants" ("name");
+-- Existing users and blogs are moved to the default tenant.
+INSERT INTO "tenants" ("id", "name", "created_at") VALUES (gen_random_uuid(), 'default', now());
+-- Modify "blogs" table
+ALTER TABLE "blogs" ADD COLUMN "tenant_id" uuid NULL;
+UPDATE "blogs" SET "tenant_id" = (SELECT "id" FROM "tenants" WHERE "name" = 'default');
+ALTER TABLE "blogs" ALTER COLUMN "tenant_id" SET NOT NULL, ADD CONSTRAINT "blogs_tenants_tenant" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "blog_tenant_id" to table: "blogs"
+CREATE INDEX "blog_tenant_id" ON "blogs" ("tenant_id");
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "tenant_id" uuid NULL;
+UPDATE "users" SET "tenant_id" = (SELECT "id" FROM "tenants" WHERE "name" = 'default');
+ALTER TABLE "users" ALTER COLUMN "tenant_id" SET NOT NULL, ADD CONSTRAINT "users_tenants_tenant" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "user_tenant_id" to table: "users"
+CREATE INDEX "user_tenant_id" ON "users" ("tenant_id");
@@ -0,0 +1,37 @@
+-- Revisions, comments and reactions belong to the tenant of their blog.
+-- Modify "blog_revisions" table
+ALTER TABLE "blog_revisions" ADD COLUMN "tenant_id" uuid NULL;
+UPDATE "blog_revisions" SET "tenant_id" = "blogs"."tenant_id" FROM "blogs" WHERE "blogs"."id" = "blog_revisions"."blog_id";
+ALTER TABLE "blog_revisions" ALTER COLUMN "tenant_id" SET NOT NULL, ADD CONSTRAINT "blog_revisions_tenants_tenant" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "blogrevision_tenant_id" to table: "blog_revisions"
+CREATE INDEX "blogrevision_tenant_id" ON "blog_revisions" ("tenant_id");
+-- Modify "comments" table
+ALTER TABLE "comments" ADD COLUMN "tenant_id" uuid NULL;
+UPDATE "comments" SET "tenant_id" = "blogs"."tenant_id" FROM "blogs" WHERE "blogs"."id" = "comments"."blog_id";
+ALTER TABLE "comments" ALTER COLUMN "tenant_id" SET NOT NULL, ADD CONSTRAINT "comments_tenants_tenant" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "comment_tenant_id" to table: "comments"
+CREATE INDEX "comment_tenant_id" ON "comments" ("tenant_id");
+-- Modify "reactions" table
+ALTER TABLE "reactions" ADD COLUMN "tenant_id" uuid NULL;
+UPDATE "reactions" SET "tenant_id" = "blogs"."tenant_id" FROM "blogs" WHERE "blogs"."id" = "reactions"."blog_id";
+ALTER TABLE "reactions" ALTER COLUMN "tenant_id" SET NOT NULL, ADD CONSTRAINT "reactions_tenants_tenant" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "reaction_tenant_id" to table: "reactions"
+CREATE INDEX "reaction_tenant_id" ON "reactions" ("tenant_id");
+-- Follows belong to the tenant of the follower.
+-- Modify "follows" table
+ALTER TABLE "follows" ADD COLUMN "tenant_id" uuid NULL;
+UPDATE "follows" SET "tenant_id" = "users"."tenant_id" FROM "users" WHERE "users"."id" = "follows"."follower_id";
+ALTER TABLE "follows" ALTER COLUMN "tenant_id" SET NOT NULL, ADD CONSTRAINT "follows_tenants_tenant" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "follow_tenant_id" to table: "follows"
+CREATE INDEX "follow_tenant_id" ON "follows" ("tenant_id");
+-- Audit logs belong to the tenant of their entity when it still exists, and
+-- to none otherwise.
+-- Modify "audit_logs" table
+ALTER TABLE "audit_logs" ADD COLUMN "tenant_id" uuid NULL, ADD CONSTRAINT "audit_logs_tenants_tenant" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+UPDATE "audit_logs" SET "tenant_id" = "users"."tenant_id" FROM "users" WHERE "audit_logs"."entity_type" = 'User' AND "audit_logs"."entity_id" = "users"."id"::text;
+UPDATE "audit_logs" SET "tenant_id" = "blogs"."tenant_id" FROM "blogs" WHERE "audit_logs"."entity_type" = 'Blog' AND "audit_logs"."entity_id" = "blogs"."id"::text;
+UPDATE "audit_logs" SET "tenant_id" = "blog_revisions"."tenant_id" FROM "blog_revisions" WHERE "audit_logs"."entity_type" = 'BlogRevision' AND "audit_logs"."entity_id" = "blog_revisions"."id"::text;
+UPDATE "audit_logs" SET "tenant_id" = "comments"."tenant_id" FROM "comments" WHERE "audit_logs"."entity_type" = 'Comment' AND "audit_logs"."entity_id" = "comments"."id"::text;
+UPDATE "audit_logs" SET "tenant_id" = "reactions"."tenant_id" FROM "reactions" WHERE "audit_logs"."entity_type" = 'Reaction' AND "audit_logs"."entity_id" = "reactions"."id"::text;
+-- Create index "auditlog_tenant_id" to table: "audit_logs"
+CREATE INDEX "auditlog_tenant_id" ON "audit_logs" ("tenant_id");
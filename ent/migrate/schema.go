@@ -8,13 +8,58 @@ import (
 )
 
 var (
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeString},
+		{Name: "op", Type: field.TypeString},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_logs_tenants_tenant",
+				Columns:    []*schema.Column{AuditLogsColumns[7]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[7]},
+			},
+			{
+				Name:    "auditlog_entity_type_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[2]},
+			},
+		},
+	}
 	// BlogsColumns holds the columns for the "blogs" table.
 	BlogsColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "title", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Size: 100},
 		{Name: "body", Type: field.TypeString, Size: 2147483647},
-		{Name: "created_at", Type: field.TypeTime},
-		{Name: "user_blog_posts", Type: field.TypeInt, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "published", "archived"}, Default: "draft"},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "user_blog_posts", Type: field.TypeUUID, Nullable: true},
 	}
 	// BlogsTable holds the schema information for the "blogs" table.
 	BlogsTable = &schema.Table{
@@ -22,35 +67,340 @@ var (
 		Columns:    BlogsColumns,
 		PrimaryKey: []*schema.Column{BlogsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blogs_tenants_tenant",
+				Columns:    []*schema.Column{BlogsColumns[11]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
 			{
 				Symbol:     "blogs_users_blog_posts",
-				Columns:    []*schema.Column{BlogsColumns[4]},
+				Columns:    []*schema.Column{BlogsColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blog_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{BlogsColumns[11]},
+			},
+			{
+				Name:    "blog_slug_user_blog_posts",
+				Unique:  true,
+				Columns: []*schema.Column{BlogsColumns[6], BlogsColumns[12]},
+			},
+		},
+	}
+	// BlogRevisionsColumns holds the columns for the "blog_revisions" table.
+	BlogRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "blog_id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+	}
+	// BlogRevisionsTable holds the schema information for the "blog_revisions" table.
+	BlogRevisionsTable = &schema.Table{
+		Name:       "blog_revisions",
+		Columns:    BlogRevisionsColumns,
+		PrimaryKey: []*schema.Column{BlogRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blog_revisions_blogs_revisions",
+				Columns:    []*schema.Column{BlogRevisionsColumns[5]},
+				RefColumns: []*schema.Column{BlogsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "blog_revisions_tenants_tenant",
+				Columns:    []*schema.Column{BlogRevisionsColumns[6]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blogrevision_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{BlogRevisionsColumns[6]},
+			},
+			{
+				Name:    "blogrevision_blog_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{BlogRevisionsColumns[5], BlogRevisionsColumns[1]},
+			},
+		},
+	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "blog_id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+		{Name: "author_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_blogs_comments",
+				Columns:    []*schema.Column{CommentsColumns[4]},
+				RefColumns: []*schema.Column{BlogsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comments_tenants_tenant",
+				Columns:    []*schema.Column{CommentsColumns[5]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "comments_comments_replies",
+				Columns:    []*schema.Column{CommentsColumns[6]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comments_users_comments",
+				Columns:    []*schema.Column{CommentsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[5]},
+			},
+			{
+				Name:    "comment_blog_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[4]},
+			},
+		},
+	}
+	// FollowsColumns holds the columns for the "follows" table.
+	FollowsColumns = []*schema.Column{
+		{Name: "followed_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "follower_id", Type: field.TypeUUID},
+		{Name: "followee_id", Type: field.TypeUUID},
+	}
+	// FollowsTable holds the schema information for the "follows" table.
+	FollowsTable = &schema.Table{
+		Name:       "follows",
+		Columns:    FollowsColumns,
+		PrimaryKey: []*schema.Column{FollowsColumns[2], FollowsColumns[3]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "follows_tenants_tenant",
+				Columns:    []*schema.Column{FollowsColumns[1]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "follows_users_follower",
+				Columns:    []*schema.Column{FollowsColumns[2]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "follows_users_followee",
+				Columns:    []*schema.Column{FollowsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "follow_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{FollowsColumns[1]},
+			},
+			{
+				Name:    "follow_followee_id",
+				Unique:  false,
+				Columns: []*schema.Column{FollowsColumns[3]},
+			},
+		},
+	}
+	// ReactionsColumns holds the columns for the "reactions" table.
+	ReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"like", "love", "laugh", "wow", "sad"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "blog_id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// ReactionsTable holds the schema information for the "reactions" table.
+	ReactionsTable = &schema.Table{
+		Name:       "reactions",
+		Columns:    ReactionsColumns,
+		PrimaryKey: []*schema.Column{ReactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reactions_blogs_reactions",
+				Columns:    []*schema.Column{ReactionsColumns[3]},
+				RefColumns: []*schema.Column{BlogsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "reactions_tenants_tenant",
+				Columns:    []*schema.Column{ReactionsColumns[4]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "reactions_users_reactions",
+				Columns:    []*schema.Column{ReactionsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reaction_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReactionsColumns[4]},
+			},
+			{
+				Name:    "reaction_user_id_blog_id_kind",
+				Unique:  true,
+				Columns: []*schema.Column{ReactionsColumns[5], ReactionsColumns[3], ReactionsColumns[1]},
+			},
+			{
+				Name:    "reaction_blog_id_kind",
+				Unique:  false,
+				Columns: []*schema.Column{ReactionsColumns[3], ReactionsColumns[1]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 64},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+	}
+	// TenantsColumns holds the columns for the "tenants" table.
+	TenantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TenantsTable holds the schema information for the "tenants" table.
+	TenantsTable = &schema.Table{
+		Name:       "tenants",
+		Columns:    TenantsColumns,
+		PrimaryKey: []*schema.Column{TenantsColumns[0]},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "name", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "title", Type: field.TypeString, Nullable: true},
-		{Name: "followers", Type: field.TypeInt, Nullable: true},
+		{Name: "legacy_followers", Type: field.TypeInt, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeUUID},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "users_tenants_tenant",
+				Columns:    []*schema.Column{UsersColumns[9]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[9]},
+			},
+		},
+	}
+	// BlogTagsColumns holds the columns for the "blog_tags" table.
+	BlogTagsColumns = []*schema.Column{
+		{Name: "blog_id", Type: field.TypeUUID},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// BlogTagsTable holds the schema information for the "blog_tags" table.
+	BlogTagsTable = &schema.Table{
+		Name:       "blog_tags",
+		Columns:    BlogTagsColumns,
+		PrimaryKey: []*schema.Column{BlogTagsColumns[0], BlogTagsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blog_tags_blog_id",
+				Columns:    []*schema.Column{BlogTagsColumns[0]},
+				RefColumns: []*schema.Column{BlogsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "blog_tags_tag_id",
+				Columns:    []*schema.Column{BlogTagsColumns[1]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditLogsTable,
 		BlogsTable,
+		BlogRevisionsTable,
+		CommentsTable,
+		FollowsTable,
+		ReactionsTable,
+		TagsTable,
+		TenantsTable,
 		UsersTable,
+		BlogTagsTable,
 	}
 )
 
 func init() {
-	BlogsTable.ForeignKeys[0].RefTable = UsersTable
+	AuditLogsTable.ForeignKeys[0].RefTable = TenantsTable
+	BlogsTable.ForeignKeys[0].RefTable = TenantsTable
+	BlogsTable.ForeignKeys[1].RefTable = UsersTable
+	BlogRevisionsTable.ForeignKeys[0].RefTable = BlogsTable
+	BlogRevisionsTable.ForeignKeys[1].RefTable = TenantsTable
+	CommentsTable.ForeignKeys[0].RefTable = BlogsTable
+	CommentsTable.ForeignKeys[1].RefTable = TenantsTable
+	CommentsTable.ForeignKeys[2].RefTable = CommentsTable
+	CommentsTable.ForeignKeys[3].RefTable = UsersTable
+	FollowsTable.ForeignKeys[0].RefTable = TenantsTable
+	FollowsTable.ForeignKeys[1].RefTable = UsersTable
+	FollowsTable.ForeignKeys[2].RefTable = UsersTable
+	ReactionsTable.ForeignKeys[0].RefTable = BlogsTable
+	ReactionsTable.ForeignKeys[1].RefTable = TenantsTable
+	ReactionsTable.ForeignKeys[2].RefTable = UsersTable
+	UsersTable.ForeignKeys[0].RefTable = TenantsTable
+	BlogTagsTable.ForeignKeys[0].RefTable = BlogsTable
+	BlogTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
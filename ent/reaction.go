@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/reaction"
+	"testMigrationEntgo/ent/tenant"
+	"testMigrationEntgo/ent/user"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Reaction is the model entity for the Reaction schema.
+type Reaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind reaction.Kind `json:"kind,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// BlogID holds the value of the "blog_id" field.
+	BlogID uuid.UUID `json:"blog_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReactionQuery when eager-loading is set.
+	Edges        ReactionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReactionEdges holds the relations/edges for other nodes in the graph.
+type ReactionEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Blog holds the value of the blog edge.
+	Blog *Blog `json:"blog,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReactionEdges) TenantOrErr() (*Tenant, error) {
+	if e.loadedTypes[0] {
+		if e.Tenant == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: tenant.Label}
+		}
+		return e.Tenant, nil
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReactionEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// BlogOrErr returns the Blog value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReactionEdges) BlogOrErr() (*Blog, error) {
+	if e.loadedTypes[2] {
+		if e.Blog == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: blog.Label}
+		}
+		return e.Blog, nil
+	}
+	return nil, &NotLoadedError{edge: "blog"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reaction.FieldID:
+			values[i] = new(sql.NullInt64)
+		case reaction.FieldKind:
+			values[i] = new(sql.NullString)
+		case reaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case reaction.FieldTenantID, reaction.FieldUserID, reaction.FieldBlogID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reaction fields.
+func (r *Reaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reaction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case reaction.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				r.TenantID = *value
+			}
+		case reaction.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				r.Kind = reaction.Kind(value.String)
+			}
+		case reaction.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				r.UserID = *value
+			}
+		case reaction.FieldBlogID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field blog_id", values[i])
+			} else if value != nil {
+				r.BlogID = *value
+			}
+		case reaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reaction.
+// This includes values selected through modifiers, order, etc.
+func (r *Reaction) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the Reaction entity.
+func (r *Reaction) QueryTenant() *TenantQuery {
+	return NewReactionClient(r.config).QueryTenant(r)
+}
+
+// QueryUser queries the "user" edge of the Reaction entity.
+func (r *Reaction) QueryUser() *UserQuery {
+	return NewReactionClient(r.config).QueryUser(r)
+}
+
+// QueryBlog queries the "blog" edge of the Reaction entity.
+func (r *Reaction) QueryBlog() *BlogQuery {
+	return NewReactionClient(r.config).QueryBlog(r)
+}
+
+// Update returns a builder for updating this Reaction.
+// Note that you need to call Reaction.Unwrap() before calling this method if this Reaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reaction) Update() *ReactionUpdateOne {
+	return NewReactionClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reaction) Unwrap() *Reaction {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reaction is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", r.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("blog_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.BlogID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reactions is a parsable slice of Reaction.
+type Reactions []*Reaction
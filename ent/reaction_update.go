@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testMigrationEntgo/ent/predicate"
+	"testMigrationEntgo/ent/reaction"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReactionUpdate is the builder for updating Reaction entities.
+type ReactionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReactionUpdate builder.
+func (ru *ReactionUpdate) Where(ps ...predicate.Reaction) *ReactionUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// Mutation returns the ReactionMutation object of the builder.
+func (ru *ReactionUpdate) Mutation() *ReactionMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReactionUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReactionUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReactionUpdate) check() error {
+	if _, ok := ru.mutation.TenantID(); ru.mutation.TenantCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Reaction.tenant"`)
+	}
+	if _, ok := ru.mutation.UserID(); ru.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Reaction.user"`)
+	}
+	if _, ok := ru.mutation.BlogID(); ru.mutation.BlogCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Reaction.blog"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ru *ReactionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReactionUpdate {
+	ru.modifiers = append(ru.modifiers, modifiers...)
+	return ru
+}
+
+func (ru *ReactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reaction.Table, reaction.Columns, sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(ru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReactionUpdateOne is the builder for updating a single Reaction entity.
+type ReactionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the ReactionMutation object of the builder.
+func (ruo *ReactionUpdateOne) Mutation() *ReactionMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReactionUpdate builder.
+func (ruo *ReactionUpdateOne) Where(ps ...predicate.Reaction) *ReactionUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReactionUpdateOne) Select(field string, fields ...string) *ReactionUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reaction entity.
+func (ruo *ReactionUpdateOne) Save(ctx context.Context) (*Reaction, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReactionUpdateOne) SaveX(ctx context.Context) *Reaction {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReactionUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReactionUpdateOne) check() error {
+	if _, ok := ruo.mutation.TenantID(); ruo.mutation.TenantCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Reaction.tenant"`)
+	}
+	if _, ok := ruo.mutation.UserID(); ruo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Reaction.user"`)
+	}
+	if _, ok := ruo.mutation.BlogID(); ruo.mutation.BlogCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Reaction.blog"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ruo *ReactionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReactionUpdateOne {
+	ruo.modifiers = append(ruo.modifiers, modifiers...)
+	return ruo
+}
+
+func (ruo *ReactionUpdateOne) sqlSave(ctx context.Context) (_node *Reaction, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reaction.Table, reaction.Columns, sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reaction.FieldID)
+		for _, f := range fields {
+			if !reaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(ruo.modifiers...)
+	_node = &Reaction{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -2,20 +2,4 @@
 
 package ent
 
-import (
-	"testMigrationEntgo/ent/blog"
-	"testMigrationEntgo/ent/schema"
-	"time"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	blogFields := schema.Blog{}.Fields()
-	_ = blogFields
-	// blogDescCreatedAt is the schema descriptor for created_at field.
-	blogDescCreatedAt := blogFields[2].Descriptor()
-	// blog.DefaultCreatedAt holds the default value on creation for the created_at field.
-	blog.DefaultCreatedAt = blogDescCreatedAt.Default.(func() time.Time)
-}
+// The schema-stitching logic is generated in testMigrationEntgo/ent/runtime/runtime.go
@@ -2,7 +2,301 @@
 
 package runtime
 
-// The schema-stitching logic is generated in testMigrationEntgo/ent/runtime.go
+import (
+	"context"
+	"testMigrationEntgo/ent/auditlog"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/comment"
+	"testMigrationEntgo/ent/follow"
+	"testMigrationEntgo/ent/reaction"
+	"testMigrationEntgo/ent/schema"
+	"testMigrationEntgo/ent/tag"
+	"testMigrationEntgo/ent/tenant"
+	"testMigrationEntgo/ent/user"
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlogMixinHooks0 := auditlogMixin[0].Hooks()
+	auditlog.Hooks[0] = auditlogMixinHooks0[0]
+	auditlogMixinInters0 := auditlogMixin[0].Interceptors()
+	auditlog.Interceptors[0] = auditlogMixinInters0[0]
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[5].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	blogMixin := schema.Blog{}.Mixin()
+	blog.Policy = privacy.NewPolicies(schema.Blog{})
+	blog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := blog.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	blogMixinHooks1 := blogMixin[1].Hooks()
+	blogMixinHooks2 := blogMixin[2].Hooks()
+	blogMixinHooks3 := blogMixin[3].Hooks()
+	blogMixinHooks4 := blogMixin[4].Hooks()
+	blogHooks := schema.Blog{}.Hooks()
+
+	blog.Hooks[1] = blogMixinHooks1[0]
+
+	blog.Hooks[2] = blogMixinHooks2[0]
+
+	blog.Hooks[3] = blogMixinHooks3[0]
+
+	blog.Hooks[4] = blogMixinHooks4[0]
+
+	blog.Hooks[5] = blogHooks[0]
+
+	blog.Hooks[6] = blogHooks[1]
+
+	blog.Hooks[7] = blogHooks[2]
+
+	blog.Hooks[8] = blogHooks[3]
+	blogMixinInters1 := blogMixin[1].Interceptors()
+	blogMixinInters3 := blogMixin[3].Interceptors()
+	blog.Interceptors[0] = blogMixinInters1[0]
+	blog.Interceptors[1] = blogMixinInters3[0]
+	blogMixinFields0 := blogMixin[0].Fields()
+	_ = blogMixinFields0
+	blogMixinFields2 := blogMixin[2].Fields()
+	_ = blogMixinFields2
+	blogMixinFields4 := blogMixin[4].Fields()
+	_ = blogMixinFields4
+	blogFields := schema.Blog{}.Fields()
+	_ = blogFields
+	// blogDescCreatedAt is the schema descriptor for created_at field.
+	blogDescCreatedAt := blogMixinFields2[0].Descriptor()
+	// blog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blog.DefaultCreatedAt = blogDescCreatedAt.Default.(func() time.Time)
+	// blogDescUpdatedAt is the schema descriptor for updated_at field.
+	blogDescUpdatedAt := blogMixinFields2[1].Descriptor()
+	// blog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	blog.DefaultUpdatedAt = blogDescUpdatedAt.Default.(func() time.Time)
+	// blogDescVersion is the schema descriptor for version field.
+	blogDescVersion := blogMixinFields4[0].Descriptor()
+	// blog.DefaultVersion holds the default value on creation for the version field.
+	blog.DefaultVersion = blogDescVersion.Default.(int)
+	// blog.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	blog.VersionValidator = blogDescVersion.Validators[0].(func(int) error)
+	// blogDescSlug is the schema descriptor for slug field.
+	blogDescSlug := blogFields[1].Descriptor()
+	// blog.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	blog.SlugValidator = func() func(string) error {
+		validators := blogDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// blogDescID is the schema descriptor for id field.
+	blogDescID := blogMixinFields0[0].Descriptor()
+	// blog.DefaultID holds the default value on creation for the id field.
+	blog.DefaultID = blogDescID.Default.(func() uuid.UUID)
+	blogrevisionMixin := schema.BlogRevision{}.Mixin()
+	blogrevisionMixinHooks0 := blogrevisionMixin[0].Hooks()
+	blogrevision.Hooks[0] = blogrevisionMixinHooks0[0]
+	blogrevisionMixinInters0 := blogrevisionMixin[0].Interceptors()
+	blogrevision.Interceptors[0] = blogrevisionMixinInters0[0]
+	blogrevisionFields := schema.BlogRevision{}.Fields()
+	_ = blogrevisionFields
+	// blogrevisionDescNumber is the schema descriptor for number field.
+	blogrevisionDescNumber := blogrevisionFields[1].Descriptor()
+	// blogrevision.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	blogrevision.NumberValidator = blogrevisionDescNumber.Validators[0].(func(int) error)
+	// blogrevisionDescCreatedAt is the schema descriptor for created_at field.
+	blogrevisionDescCreatedAt := blogrevisionFields[4].Descriptor()
+	// blogrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blogrevision.DefaultCreatedAt = blogrevisionDescCreatedAt.Default.(func() time.Time)
+	commentMixin := schema.Comment{}.Mixin()
+	commentMixinHooks0 := commentMixin[0].Hooks()
+	commentMixinHooks1 := commentMixin[1].Hooks()
+	commentHooks := schema.Comment{}.Hooks()
+	comment.Hooks[0] = commentMixinHooks0[0]
+	comment.Hooks[1] = commentMixinHooks1[0]
+	comment.Hooks[2] = commentHooks[0]
+	comment.Hooks[3] = commentHooks[1]
+	commentMixinInters0 := commentMixin[0].Interceptors()
+	comment.Interceptors[0] = commentMixinInters0[0]
+	commentMixinFields1 := commentMixin[1].Fields()
+	_ = commentMixinFields1
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentMixinFields1[0].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	// commentDescUpdatedAt is the schema descriptor for updated_at field.
+	commentDescUpdatedAt := commentMixinFields1[1].Descriptor()
+	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
+	// commentDescBody is the schema descriptor for body field.
+	commentDescBody := commentFields[0].Descriptor()
+	// comment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	comment.BodyValidator = commentDescBody.Validators[0].(func(string) error)
+	followMixin := schema.Follow{}.Mixin()
+	followMixinHooks0 := followMixin[0].Hooks()
+	follow.Hooks[0] = followMixinHooks0[0]
+	followMixinInters0 := followMixin[0].Interceptors()
+	follow.Interceptors[0] = followMixinInters0[0]
+	followFields := schema.Follow{}.Fields()
+	_ = followFields
+	// followDescFollowedAt is the schema descriptor for followed_at field.
+	followDescFollowedAt := followFields[0].Descriptor()
+	// follow.DefaultFollowedAt holds the default value on creation for the followed_at field.
+	follow.DefaultFollowedAt = followDescFollowedAt.Default.(func() time.Time)
+	reactionMixin := schema.Reaction{}.Mixin()
+	reactionMixinHooks0 := reactionMixin[0].Hooks()
+	reactionHooks := schema.Reaction{}.Hooks()
+	reaction.Hooks[0] = reactionMixinHooks0[0]
+	reaction.Hooks[1] = reactionHooks[0]
+	reactionMixinInters0 := reactionMixin[0].Interceptors()
+	reaction.Interceptors[0] = reactionMixinInters0[0]
+	reactionFields := schema.Reaction{}.Fields()
+	_ = reactionFields
+	// reactionDescCreatedAt is the schema descriptor for created_at field.
+	reactionDescCreatedAt := reactionFields[3].Descriptor()
+	// reaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reaction.DefaultCreatedAt = reactionDescCreatedAt.Default.(func() time.Time)
+	tag.Policy = privacy.NewPolicies(schema.Tag{})
+	tag.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tag.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tagHooks := schema.Tag{}.Hooks()
+
+	tag.Hooks[1] = tagHooks[0]
+
+	tag.Hooks[2] = tagHooks[1]
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	tenantMixin := schema.Tenant{}.Mixin()
+	tenantHooks := schema.Tenant{}.Hooks()
+	tenant.Hooks[0] = tenantHooks[0]
+	tenantMixinFields0 := tenantMixin[0].Fields()
+	_ = tenantMixinFields0
+	tenantFields := schema.Tenant{}.Fields()
+	_ = tenantFields
+	// tenantDescName is the schema descriptor for name field.
+	tenantDescName := tenantFields[0].Descriptor()
+	// tenant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tenant.NameValidator = tenantDescName.Validators[0].(func(string) error)
+	// tenantDescCreatedAt is the schema descriptor for created_at field.
+	tenantDescCreatedAt := tenantFields[1].Descriptor()
+	// tenant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenant.DefaultCreatedAt = tenantDescCreatedAt.Default.(func() time.Time)
+	// tenantDescID is the schema descriptor for id field.
+	tenantDescID := tenantMixinFields0[0].Descriptor()
+	// tenant.DefaultID holds the default value on creation for the id field.
+	tenant.DefaultID = tenantDescID.Default.(func() uuid.UUID)
+	userMixin := schema.User{}.Mixin()
+	user.Policy = privacy.NewPolicies(schema.User{})
+	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := user.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userMixinHooks1 := userMixin[1].Hooks()
+	userMixinHooks2 := userMixin[2].Hooks()
+	userMixinHooks3 := userMixin[3].Hooks()
+	userMixinHooks4 := userMixin[4].Hooks()
+	userHooks := schema.User{}.Hooks()
+
+	user.Hooks[1] = userMixinHooks1[0]
+
+	user.Hooks[2] = userMixinHooks2[0]
+
+	user.Hooks[3] = userMixinHooks3[0]
+
+	user.Hooks[4] = userMixinHooks4[0]
+
+	user.Hooks[5] = userHooks[0]
+
+	user.Hooks[6] = userHooks[1]
+	userMixinInters1 := userMixin[1].Interceptors()
+	userMixinInters3 := userMixin[3].Interceptors()
+	user.Interceptors[0] = userMixinInters1[0]
+	user.Interceptors[1] = userMixinInters3[0]
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userMixinFields2 := userMixin[2].Fields()
+	_ = userMixinFields2
+	userMixinFields4 := userMixin[4].Fields()
+	_ = userMixinFields4
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userMixinFields2[0].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userMixinFields2[1].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// userDescVersion is the schema descriptor for version field.
+	userDescVersion := userMixinFields4[0].Descriptor()
+	// user.DefaultVersion holds the default value on creation for the version field.
+	user.DefaultVersion = userDescVersion.Default.(int)
+	// user.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	user.VersionValidator = userDescVersion.Validators[0].(func(int) error)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[1].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userMixinFields0[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.12.5"                                         // Version of ent codegen.
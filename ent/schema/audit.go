@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+)
+
+// AuditFunc records the changes made by m, which it runs by calling next.
+// The op is the one m was made with, which differs from the one of m for
+// soft deletions, run as updates.
+type AuditFunc func(ctx context.Context, op ent.Op, m ent.Mutation, next ent.Mutator) (ent.Value, error)
+
+type (
+	auditKey      struct{}
+	softDeleteKey struct{}
+)
+
+// softDeletion is a deletion run as an update by the SoftDeleteMixin.
+type softDeletion struct {
+	m  ent.Mutation
+	op ent.Op
+}
+
+// WithAudit returns a context whose mutations of audited entities are
+// recorded by fn.
+func WithAudit(parent context.Context, fn AuditFunc) context.Context {
+	return context.WithValue(parent, auditKey{}, fn)
+}
+
+// auditHook runs the AuditFunc of the context, if any. It is the last hook
+// of the audited schemas, so that the rows a mutation changes are known
+// only once the other hooks, such as the privacy and tenant ones, have
+// restricted them.
+func auditHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		fn, ok := ctx.Value(auditKey{}).(AuditFunc)
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+		op := m.Op()
+		if d, ok := ctx.Value(softDeleteKey{}).(softDeletion); ok && d.m == m {
+			op = d.op
+		}
+		return fn(ctx, op, m, next)
+	})
+}
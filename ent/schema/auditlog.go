@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"testMigrationEntgo/ent/schema/schematype"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity, a change
+// made to another entity.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Mixin of the AuditLog. Changes made for all tenants, and changes to
+// entities belonging to none, are logged for none.
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{Optional: true},
+	}
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("entity_type").Immutable(),
+		field.String("entity_id").Immutable().
+			Comment("The id of the entity, formatted as text as ids are of different types"),
+		field.String("op").Immutable(),
+		field.JSON("changes", map[string]schematype.FieldChange{}).Optional().Immutable(),
+		field.String("actor").Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id"),
+	}
+}
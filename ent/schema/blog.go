@@ -1,11 +1,22 @@
 package schema
 
 import (
+	"context"
+	"fmt"
 	"time"
 
+	gen "testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/hook"
+	"testMigrationEntgo/ent/privacy"
+	"testMigrationEntgo/ent/user"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 )
 
 // Blog holds the schema definition for the Blog entity.
@@ -13,19 +24,209 @@ type Blog struct {
 	ent.Schema
 }
 
+// Mixin of the Blog.
+func (Blog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TenantMixin{},
+		TimestampsMixin{},
+		SoftDeleteMixin{},
+		VersionMixin{},
+	}
+}
+
 // Fields of the Blog.
 func (Blog) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("title"),
+		field.String("slug").NotEmpty().MaxLen(100).Match(slugPattern).
+			Comment("Generated from the title on creation and kept when it changes"),
 		field.Text("body"),
-		field.Time("created_at").Default(time.Now),
+		field.Enum("status").Values("draft", "published", "archived").Default("draft"),
+		field.Time("published_at").Optional().Nillable(),
+		field.Time("archived_at").Optional().Nillable(),
 	}
 
 }
 
+// Indexes of the Blog.
+func (Blog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("slug").Edges("author").Unique(),
+	}
+}
+
 // Edges of the Blog.
 func (Blog) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("author", User.Type).Unique().Ref("blog_posts"),
+		edge.To("revisions", BlogRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tags", Tag.Type),
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reactions", Reaction.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Hooks of the Blog.
+func (Blog) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(statusTransitionHook, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(slugHook, ent.OpCreate),
+		hook.On(revisionHook, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+		auditHook,
+	}
+}
+
+// Policy of the Blog: only its author or an admin can create, update or
+// delete a blog.
+func (Blog) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			denyIfNoViewer(),
+			allowIfAdmin(),
+			authorRule(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
+
+// authorRule allows viewers to create blogs of their own, and to update or
+// delete them, but not to hand them over to someone else.
+func authorRule() privacy.MutationRule {
+	return privacy.BlogMutationRuleFunc(func(ctx context.Context, m *gen.BlogMutation) error {
+		v, _ := ViewerFromContext(ctx)
+		if id, ok := m.AuthorID(); (ok && id != v.ID) || m.AuthorCleared() {
+			return privacy.Denyf("schema: viewer %s can only author blogs of their own", v.ID)
+		}
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			if _, ok := m.AuthorID(); !ok {
+				return privacy.Denyf("schema: viewer %s can only author blogs of their own", v.ID)
+			}
+		case m.Op().Is(ent.OpUpdateOne | ent.OpDeleteOne):
+			id, _ := m.ID()
+			own, err := txClient(ctx, m.Client()).Blog.Query().
+				Where(blog.ID(id), blog.HasAuthorWith(user.ID(v.ID))).
+				Exist(IncludeDeleted(ctx))
+			if err != nil {
+				return err
+			}
+			if !own {
+				return privacy.Denyf("schema: blog %s is not authored by viewer %s", id, v.ID)
+			}
+		default:
+			// Rows of a bulk update or delete are not loaded, so it is
+			// restricted to the blogs of the viewer.
+			m.Where(blog.HasAuthorWith(user.ID(v.ID)))
+		}
+		return privacy.Allow
+	})
+}
+
+// ownsBlogs reports whether every blog of ids is authored by the viewer, for
+// the policies of the schemas having an edge to blogs.
+func ownsBlogs(ctx context.Context, client *gen.Client, v Viewer, ids []uuid.UUID) (bool, error) {
+	n, err := txClient(ctx, client).Blog.Query().
+		Where(blog.IDIn(ids...), blog.HasAuthorWith(user.ID(v.ID))).
+		Count(IncludeDeleted(ctx))
+	return n == len(ids), err
+}
+
+// statusTransitions maps every status to the statuses a blog can move to.
+var statusTransitions = map[blog.Status][]blog.Status{
+	blog.StatusDraft:     {blog.StatusPublished, blog.StatusArchived},
+	blog.StatusPublished: {blog.StatusArchived},
+	blog.StatusArchived:  {blog.StatusDraft, blog.StatusPublished},
+}
+
+// statusSources returns the statuses a blog can move to status from.
+func statusSources(status blog.Status) []blog.Status {
+	var from []blog.Status
+	for s, to := range statusTransitions {
+		for _, t := range to {
+			if t == status {
+				from = append(from, s)
+			}
+		}
+	}
+	return from
+}
+
+// statusTransitionHook rejects invalid status transitions and stamps the
+// time a blog was published or archived.
+func statusTransitionHook(next ent.Mutator) ent.Mutator {
+	return hook.BlogFunc(func(ctx context.Context, m *gen.BlogMutation) (ent.Value, error) {
+		status, ok := m.Status()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+		switch {
+		case m.Op().Is(ent.OpUpdateOne):
+			// The status is loaded in the transaction the update runs in,
+			// as OldStatus would return the one of the entity given to
+			// UpdateOne, or load it outside of the transaction.
+			id, _ := m.ID()
+			b, err := txClient(ctx, m.Client()).Blog.Query().
+				Where(blog.ID(id)).
+				Select(blog.FieldStatus).
+				Only(IncludeDeleted(ctx))
+			if err != nil {
+				return nil, err
+			}
+			old := b.Status
+			// The status may have changed since it was loaded, so the
+			// update only applies to the status it was checked against.
+			m.Where(blog.StatusEQ(old))
+			if old == status {
+				return next.Mutate(ctx, m)
+			}
+			if !validTransition(old, status) {
+				return nil, gen.NewValidationError(blog.FieldStatus, fmt.Errorf("schema: invalid status transition from %q to %q", old, status))
+			}
+		case m.Op().Is(ent.OpUpdate):
+			// A bulk update fails if any of its rows can't move to the new
+			// status. Rows already in it are left alone, keeping their
+			// timestamps.
+			sources := statusSources(status)
+			ids, err := m.IDs(IncludeDeleted(ctx))
+			if err != nil {
+				return nil, err
+			}
+			invalid, err := txClient(ctx, m.Client()).Blog.Query().
+				Where(blog.IDIn(ids...), blog.StatusNotIn(append(sources, status)...)).
+				IDs(IncludeDeleted(ctx))
+			if err != nil {
+				return nil, err
+			}
+			if len(invalid) > 0 {
+				return nil, gen.NewValidationError(blog.FieldStatus, fmt.Errorf("schema: invalid status transition to %q for blogs %v", status, invalid))
+			}
+			// The statuses may have changed since they were checked.
+			m.Where(blog.StatusIn(sources...))
+		}
+		now := time.Now()
+		switch status {
+		case blog.StatusPublished:
+			if _, ok := m.PublishedAt(); !ok {
+				m.SetPublishedAt(now)
+			}
+		case blog.StatusArchived:
+			if _, ok := m.ArchivedAt(); !ok {
+				m.SetArchivedAt(now)
+			}
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+func validTransition(from, to blog.Status) bool {
+	for _, s := range statusTransitions[from] {
+		if s == to {
+			return true
+		}
 	}
+	return false
 }
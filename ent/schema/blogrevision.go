@@ -0,0 +1,167 @@
+package schema
+
+import (
+	"context"
+	"time"
+
+	gen "testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/hook"
+	"testMigrationEntgo/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// BlogRevision holds the schema definition for the BlogRevision entity, a
+// version of the title and body of a blog. Revisions of a blog are numbered
+// from 1 and the latest one matches the blog.
+type BlogRevision struct {
+	ent.Schema
+}
+
+// Mixin of the BlogRevision.
+func (BlogRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the BlogRevision.
+func (BlogRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("blog_id", uuid.UUID{}).Immutable(),
+		field.Int("number").Positive().Immutable(),
+		field.String("title").Immutable(),
+		field.Text("body").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the BlogRevision.
+func (BlogRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("blog", Blog.Type).Ref("revisions").Field("blog_id").Unique().Required().Immutable(),
+	}
+}
+
+// Indexes of the BlogRevision.
+func (BlogRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("blog_id", "number").Unique(),
+	}
+}
+
+type noRevisionsKey struct{}
+
+// NoRevisions returns a context whose blog mutations store no revision, for
+// bulk creates storing the first revisions of their blogs themselves, in a
+// single insert rather than one per blog.
+func NoRevisions(parent context.Context) context.Context {
+	return context.WithValue(parent, noRevisionsKey{}, true)
+}
+
+// revisionHook stores a new revision of every blog whose title or body is
+// changed by the mutation.
+func revisionHook(next ent.Mutator) ent.Mutator {
+	return hook.BlogFunc(func(ctx context.Context, m *gen.BlogMutation) (ent.Value, error) {
+		_, title := m.Title()
+		_, body := m.Body()
+		if skip, _ := ctx.Value(noRevisionsKey{}).(bool); skip || (!title && !body) {
+			return next.Mutate(ctx, m)
+		}
+		var ids []uuid.UUID
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = m.IDs(IncludeDeleted(ctx)); err != nil {
+				return nil, err
+			}
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		client := txClient(ctx, m.Client())
+		if m.Op().Is(ent.OpCreate) {
+			// A new blog has no revision yet, and the mutation holds all
+			// of the first one.
+			id, _ := m.ID()
+			tenantID, _ := m.TenantID()
+			title, _ := m.Title()
+			body, _ := m.Body()
+			err := client.BlogRevision.Create().
+				SetTenantID(tenantID).
+				SetBlogID(id).
+				SetNumber(1).
+				SetTitle(title).
+				SetBody(body).
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return v, nil
+		}
+		// Blogs are reloaded as a bulk update may skip some of them, and
+		// only sets one of the fields.
+		blogs, err := client.Blog.Query().
+			Where(blog.IDIn(ids...)).
+			All(IncludeDeleted(ctx))
+		if err != nil {
+			return nil, err
+		}
+		latest, err := client.BlogRevision.Query().
+			Where(blogrevision.BlogIDIn(ids...), latestRevision()).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		byBlog := make(map[uuid.UUID]*gen.BlogRevision, len(latest))
+		for _, r := range latest {
+			byBlog[r.BlogID] = r
+		}
+		var changed []*gen.Blog
+		for _, b := range blogs {
+			if r := byBlog[b.ID]; r == nil || r.Title != b.Title || r.Body != b.Body {
+				changed = append(changed, b)
+			}
+		}
+		if len(changed) == 0 {
+			return v, nil
+		}
+		// Revisions belong to the tenant of their blog, which the context
+		// doesn't tell when it is for all tenants.
+		err = client.BlogRevision.MapCreateBulk(changed, func(c *gen.BlogRevisionCreate, i int) {
+			b, number := changed[i], 1
+			if r := byBlog[b.ID]; r != nil {
+				number = r.Number + 1
+			}
+			c.SetTenantID(b.TenantID).
+				SetBlogID(b.ID).
+				SetNumber(number).
+				SetTitle(b.Title).
+				SetBody(b.Body)
+		}).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	})
+}
+
+// latestRevision matches the revisions numbered the highest of their blog.
+func latestRevision() predicate.BlogRevision {
+	return func(s *sql.Selector) {
+		t := sql.Table(blogrevision.Table).As("latest")
+		s.Where(sql.EQ(
+			s.C(blogrevision.FieldNumber),
+			sql.Select(sql.Max(t.C(blogrevision.FieldNumber))).
+				From(t).
+				Where(sql.ColumnsEQ(t.C(blogrevision.FieldBlogID), s.C(blogrevision.FieldBlogID))),
+		))
+	}
+}
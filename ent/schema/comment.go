@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	gen "testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/comment"
+	"testMigrationEntgo/ent/hook"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Comment holds the schema definition for the Comment entity, a comment on
+// a blog or a reply to another comment on the same blog.
+type Comment struct {
+	ent.Schema
+}
+
+// Mixin of the Comment.
+func (Comment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+		TimestampsMixin{},
+	}
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("body").NotEmpty(),
+		field.UUID("blog_id", uuid.UUID{}).Immutable(),
+		field.UUID("author_id", uuid.UUID{}).Optional().Nillable().Immutable().
+			Comment("Unset once the author is purged, the comment is kept for its replies"),
+		field.Int("parent_id").Optional().Nillable().Immutable(),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("blog", Blog.Type).Ref("comments").Field("blog_id").Unique().Required().Immutable(),
+		edge.From("author", User.Type).Ref("comments").Field("author_id").Unique().Immutable(),
+		edge.To("replies", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			From("parent").Field("parent_id").Unique().Immutable(),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		// Threads are loaded a blog at a time.
+		index.Fields("blog_id"),
+	}
+}
+
+// Hooks of the Comment.
+func (Comment) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(sameBlogReplyHook, ent.OpCreate),
+		auditHook,
+	}
+}
+
+// sameBlogReplyHook rejects replies to a comment on another blog.
+func sameBlogReplyHook(next ent.Mutator) ent.Mutator {
+	return hook.CommentFunc(func(ctx context.Context, m *gen.CommentMutation) (ent.Value, error) {
+		parentID, ok := m.ParentID()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+		blogID, _ := m.BlogID()
+		parent, err := txClient(ctx, m.Client()).Comment.Query().
+			Where(comment.ID(parentID)).
+			Select(comment.FieldBlogID).
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if parent.BlogID != blogID {
+			return nil, gen.NewValidationError(comment.FieldParentID, fmt.Errorf("schema: comment %d is on another blog", parentID))
+		}
+		return next.Mutate(ctx, m)
+	})
+}
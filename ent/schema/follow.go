@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Follow holds the schema definition for the Follow entity, the edge
+// schema of the User following edge. Follows are created with the Follow
+// client, which stamps their tenant, rather than through that edge.
+type Follow struct {
+	ent.Schema
+}
+
+// Annotations of the Follow.
+func (Follow) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("follower_id", "followee_id"),
+	}
+}
+
+// Mixin of the Follow.
+func (Follow) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Follow.
+func (Follow) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("followed_at").Default(time.Now).Immutable(),
+		field.UUID("follower_id", uuid.UUID{}).Immutable(),
+		field.UUID("followee_id", uuid.UUID{}).Immutable(),
+	}
+}
+
+// Edges of the Follow.
+func (Follow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("follower", User.Type).Required().Unique().Immutable().Field("follower_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("followee", User.Type).Required().Unique().Immutable().Field("followee_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Follow.
+func (Follow) Indexes() []ent.Index {
+	return []ent.Index{
+		// The primary key covers looking up who a user follows, this one
+		// covers looking up their followers.
+		index.Fields("followee_id"),
+	}
+}
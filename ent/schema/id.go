@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// UUIDMixin replaces the bigint identity id of a schema with a UUIDv7.
+// Unlike sequential ids, these don't leak how many rows a table holds and
+// don't clash when merging databases, while still being ordered by creation
+// time, which keeps inserts into the primary key index local.
+type UUIDMixin struct {
+	mixin.Schema
+}
+
+// Fields of the UUIDMixin.
+func (UUIDMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(NewID).Immutable(),
+	}
+}
+
+// NewID returns a new UUIDv7. It only panics if the system random source
+// fails, as uuid.New does.
+func NewID() uuid.UUID {
+	return uuid.Must(uuid.NewV7())
+}
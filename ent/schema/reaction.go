@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Reaction holds the schema definition for the Reaction entity. A user
+// reacts to a blog at most once with each kind.
+type Reaction struct {
+	ent.Schema
+}
+
+// Mixin of the Reaction.
+func (Reaction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Reaction.
+func (Reaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").Values("like", "love", "laugh", "wow", "sad").Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.UUID("blog_id", uuid.UUID{}).Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Reaction.
+func (Reaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("reactions").Field("user_id").Unique().Required().Immutable(),
+		edge.From("blog", Blog.Type).Ref("reactions").Field("blog_id").Unique().Required().Immutable(),
+	}
+}
+
+// Hooks of the Reaction.
+func (Reaction) Hooks() []ent.Hook {
+	return []ent.Hook{
+		auditHook,
+	}
+}
+
+// Indexes of the Reaction.
+func (Reaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "blog_id", "kind").Unique(),
+		// Counts are aggregated by blog and kind.
+		index.Fields("blog_id", "kind"),
+	}
+}
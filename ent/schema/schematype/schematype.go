@@ -0,0 +1,10 @@
+// Package schematype holds the Go types of the JSON fields of the schema.
+// It is kept apart from the schema package, which imports the generated
+// code, as the generated code imports the types in turn.
+package schematype
+
+// FieldChange is the value of a field before and after a mutation.
+type FieldChange struct {
+	Old any `json:"old,omitempty"`
+	New any `json:"new,omitempty"`
+}
@@ -0,0 +1,111 @@
+package schema
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	gen "testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/hook"
+	"testMigrationEntgo/ent/user"
+
+	"entgo.io/ent"
+	"golang.org/x/text/unicode/norm"
+)
+
+// slugPattern matches slugs: lower case ASCII words separated by dashes.
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// maxSlugBase is the length slugs are cut to before a collision suffix is
+// added, which keeps them within the 100 characters of the field.
+const maxSlugBase = 80
+
+// transliterations spells out the letters that don't decompose into an
+// ASCII letter and a diacritic.
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d",
+	'ł': "l", 'þ': "th", 'ı': "i", 'ŋ': "n", 'ħ': "h",
+}
+
+// Slugify returns the slug of a title: diacritics are dropped, a few
+// letters are transliterated, and every other run of characters that are
+// not ASCII letters or digits becomes a dash. Titles without any such
+// character get the "post" slug.
+func Slugify(title string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range norm.NFD.String(strings.ToLower(title)) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		s, ok := transliterations[r]
+		if !ok {
+			s = string(r)
+		}
+		for _, r := range s {
+			if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+				if dash && sb.Len() > 0 {
+					sb.WriteByte('-')
+				}
+				sb.WriteRune(r)
+				dash = false
+			} else {
+				dash = true
+			}
+		}
+	}
+	slug := sb.String()
+	if len(slug) > maxSlugBase {
+		slug = slug[:maxSlugBase]
+		if i := strings.LastIndexByte(slug, '-'); i > 0 {
+			slug = slug[:i]
+		}
+	}
+	if slug == "" {
+		return "post"
+	}
+	return slug
+}
+
+// slugHook generates the slug of blogs created without one, adding a
+// numeric suffix when the author already has a blog with the same slug.
+// Deleted blogs keep their slug, so they are taken into account.
+func slugHook(next ent.Mutator) ent.Mutator {
+	return hook.BlogFunc(func(ctx context.Context, m *gen.BlogMutation) (ent.Value, error) {
+		if _, ok := m.Slug(); ok {
+			return next.Mutate(ctx, m)
+		}
+		title, _ := m.Title()
+		base := Slugify(title)
+		author := blog.Not(blog.HasAuthor())
+		if id, ok := m.AuthorID(); ok {
+			author = blog.HasAuthorWith(user.ID(id))
+		}
+		taken, err := txClient(ctx, m.Client()).Blog.Query().
+			Where(author, blog.Or(blog.Slug(base), blog.SlugHasPrefix(base+"-"))).
+			Select(blog.FieldSlug).
+			Strings(IncludeDeleted(ctx))
+		if err != nil {
+			return nil, err
+		}
+		m.SetSlug(freeSlug(base, taken))
+		return next.Mutate(ctx, m)
+	})
+}
+
+// freeSlug returns base, or base followed by the lowest suffix from 2 on,
+// that is not taken.
+func freeSlug(base string, taken []string) string {
+	used := make(map[string]bool, len(taken))
+	for _, s := range taken {
+		used[s] = true
+	}
+	slug := base
+	for n := 2; used[slug]; n++ {
+		slug = base + "-" + strconv.Itoa(n)
+	}
+	return slug
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		title, want string
+	}{
+		{"Hello, World!", "hello-world"},
+		{"  Go 1.21 released  ", "go-1-21-released"},
+		{"Crème brûlée", "creme-brulee"},
+		{"Straße in Łódź", "strasse-in-lodz"},
+		{"Smørrebrød & Æbleskiver", "smorrebrod-aebleskiver"},
+		{"--already-a-slug--", "already-a-slug"},
+		{"日本語", "post"},
+		{"", "post"},
+	}
+	for _, tt := range tests {
+		if got := Slugify(tt.title); got != tt.want {
+			t.Errorf("Slugify(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+		if got := Slugify(tt.title); !slugPattern.MatchString(got) {
+			t.Errorf("Slugify(%q) = %q, which is not a valid slug", tt.title, got)
+		}
+	}
+}
+
+func TestSlugifyLength(t *testing.T) {
+	got := Slugify(strings.Repeat("word ", 40))
+	if len(got) > maxSlugBase {
+		t.Errorf("got a slug of %d characters, want at most %d", len(got), maxSlugBase)
+	}
+	if strings.HasSuffix(got, "-") || !strings.HasSuffix(got, "word") {
+		t.Errorf("got slug %q, want it cut between words", got)
+	}
+}
+
+func TestFreeSlug(t *testing.T) {
+	tests := []struct {
+		name  string
+		base  string
+		taken []string
+		want  string
+	}{
+		{"free", "a", nil, "a"},
+		{"unrelated", "a", []string{"b", "a-b"}, "a"},
+		{"taken", "a", []string{"a"}, "a-2"},
+		{"gap", "a", []string{"a", "a-3"}, "a-2"},
+		{"suffixes taken", "a", []string{"a", "a-2", "a-3"}, "a-4"},
+		{"base ending in a suffix", "a-2", []string{"a", "a-2"}, "a-2-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := freeSlug(tt.base, tt.taken); got != tt.want {
+				t.Errorf("freeSlug(%q, %q) = %q, want %q", tt.base, tt.taken, got, tt.want)
+			}
+		})
+	}
+}
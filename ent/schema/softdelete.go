@@ -0,0 +1,105 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gen "testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/hook"
+	"testMigrationEntgo/ent/intercept"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SoftDeleteMixin implements the soft delete pattern for schemas: deleting
+// rows only stamps their deleted_at field, and queries skip stamped rows.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").Optional().Nillable(),
+	}
+}
+
+type (
+	includeDeletedKey struct{}
+	hardDeleteKey     struct{}
+)
+
+// IncludeDeleted returns a context whose queries also return soft-deleted rows.
+func IncludeDeleted(parent context.Context) context.Context {
+	return context.WithValue(parent, includeDeletedKey{}, true)
+}
+
+// HardDelete returns a context whose deletions remove rows for good. Rows
+// that were soft-deleted before are visible to them.
+func HardDelete(parent context.Context) context.Context {
+	return context.WithValue(IncludeDeleted(parent), hardDeleteKey{}, true)
+}
+
+// Interceptors of the SoftDeleteMixin.
+func (d SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if include, _ := ctx.Value(includeDeletedKey{}).(bool); !include {
+				d.notDeleted(q)
+			}
+			return nil
+		}),
+	}
+}
+
+// Hooks of the SoftDeleteMixin.
+func (d SoftDeleteMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					if hard, _ := ctx.Value(hardDeleteKey{}).(bool); hard {
+						return next.Mutate(ctx, m)
+					}
+					mx, ok := m.(interface {
+						SetOp(ent.Op)
+						Client() *gen.Client
+						SetDeletedAt(time.Time)
+						WhereP(...func(*sql.Selector))
+					})
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type %T", m)
+					}
+					// Already deleted rows keep their original deletion time.
+					d.notDeleted(mx)
+					ctx = context.WithValue(ctx, softDeleteKey{}, softDeletion{m: m, op: m.Op()})
+					mx.SetOp(ent.OpUpdate)
+					mx.SetDeletedAt(time.Now())
+					// The mutation runs again as an update.
+					return txClient(ctx, mx.Client()).Mutate(ctx, m)
+				})
+			},
+			ent.OpDeleteOne|ent.OpDelete,
+		),
+	}
+}
+
+// notDeleted filters out soft-deleted rows.
+func (d SoftDeleteMixin) notDeleted(w interface{ WhereP(...func(*sql.Selector)) }) {
+	w.WhereP(sql.FieldIsNull(d.Fields()[0].Descriptor().Name))
+}
+
+// txClient returns the client of the transaction in ctx, if any, or client.
+// Hooks use it to make their own changes in the transaction the mutation
+// runs in, as the client of a mutation re-entered in a transaction started
+// by an earlier hook is not bound to it.
+func txClient(ctx context.Context, client *gen.Client) *gen.Client {
+	if tx := gen.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return client
+}
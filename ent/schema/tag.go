@@ -0,0 +1,125 @@
+package schema
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	gen "testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/hook"
+	"testMigrationEntgo/ent/privacy"
+	"testMigrationEntgo/ent/tag"
+	"testMigrationEntgo/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Tag holds the schema definition for the Tag entity. Tag names are stored
+// normalized, see NormalizeTag.
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().MaxLen(64).Unique(),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("blogs", Blog.Type).Ref("tags"),
+	}
+}
+
+// Hooks of the Tag.
+func (Tag) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.TagFunc(func(ctx context.Context, m *gen.TagMutation) (ent.Value, error) {
+					if name, ok := m.Name(); ok {
+						m.SetName(NormalizeTag(name))
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+		),
+		auditHook,
+	}
+}
+
+// Policy of the Tag: tags are added to and removed from blogs through the
+// blogs edge as well as through the tags of the Blog, so that edge is only
+// changed by an admin, or by a viewer on their own blogs.
+func (Tag) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			allowIfAdmin(),
+			tagBlogsRule(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
+
+// tagBlogsRule allows viewers to change the blogs edge of tags only when
+// every blog involved is their own. Clearing the edge involves every blog
+// of the tag, which is only known when a single tag is updated.
+func tagBlogsRule() privacy.MutationRule {
+	return privacy.TagMutationRuleFunc(func(ctx context.Context, m *gen.TagMutation) error {
+		ids := append(m.BlogsIDs(), m.RemovedBlogsIDs()...)
+		if len(ids) == 0 && !m.BlogsCleared() {
+			return privacy.Skip
+		}
+		v, ok := ViewerFromContext(ctx)
+		if !ok {
+			return privacy.Denyf("schema: no viewer in context")
+		}
+		if len(ids) > 0 {
+			own, err := ownsBlogs(ctx, m.Client(), v, ids)
+			if err != nil {
+				return err
+			}
+			if !own {
+				return privacy.Denyf("schema: viewer %s can only tag blogs of their own", v.ID)
+			}
+		}
+		if !m.BlogsCleared() {
+			return privacy.Skip
+		}
+		id, ok := m.ID()
+		if !m.Op().Is(ent.OpUpdateOne) || !ok {
+			return privacy.Denyf("schema: viewer %s can only clear the blogs of a single tag", v.ID)
+		}
+		others, err := txClient(ctx, m.Client()).Blog.Query().
+			Where(
+				blog.HasTagsWith(tag.ID(id)),
+				blog.Not(blog.HasAuthorWith(user.ID(v.ID))),
+			).
+			Exist(IncludeDeleted(ctx))
+		if err != nil {
+			return err
+		}
+		if others {
+			return privacy.Denyf("schema: viewer %s can only tag blogs of their own", v.ID)
+		}
+		return privacy.Skip
+	})
+}
+
+// NormalizeTag returns the normalized form of a tag name: lower case,
+// without a leading #, and with words separated by single dashes, so that
+// "Go Lang", "#go_lang" and "go-lang" are the same tag.
+func NormalizeTag(name string) string {
+	name = strings.TrimPrefix(strings.TrimSpace(name), "#")
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return r == '-' || r == '_' || unicode.IsSpace(r)
+	})
+	return strings.Join(words, "-")
+}
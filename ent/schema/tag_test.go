@@ -0,0 +1,27 @@
+package schema
+
+import "testing"
+
+func TestNormalizeTag(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"go", "go"},
+		{"Go", "go"},
+		{"#go", "go"},
+		{"  #Go  ", "go"},
+		{"Go Lang", "go-lang"},
+		{"#go_lang", "go-lang"},
+		{"go-lang", "go-lang"},
+		{"go -- _ lang", "go-lang"},
+		{"-go-", "go"},
+		{"##go", "#go"},
+		{"", ""},
+		{"#", ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizeTag(tt.name); got != tt.want {
+			t.Errorf("NormalizeTag(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"testMigrationEntgo/ent/intercept"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// ErrNoTenant is returned by queries and mutations of entities belonging to
+// a tenant when the context has none, see WithTenant and AllTenants.
+var ErrNoTenant = errors.New("schema: no tenant in context")
+
+type (
+	tenantKey     struct{}
+	allTenantsKey struct{}
+)
+
+// WithTenant returns a context whose queries and mutations are restricted
+// to the given tenant, and whose creates belong to it.
+func WithTenant(parent context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(parent, tenantKey{}, id)
+}
+
+// TenantFromContext returns the tenant set by WithTenant, if any.
+func TenantFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(tenantKey{}).(uuid.UUID)
+	return id, ok
+}
+
+// AllTenants returns a context whose queries and mutations span all tenants,
+// for administrative tasks. Creates must set their tenant explicitly.
+func AllTenants(parent context.Context) context.Context {
+	return context.WithValue(parent, allTenantsKey{}, true)
+}
+
+// TenantScope returns the tenant rows are restricted to in ctx, or false if
+// ctx spans all tenants. It fails with ErrNoTenant if ctx does neither, for
+// queries that can't rely on the TenantMixin filtering them, such as joins.
+func TenantScope(ctx context.Context) (uuid.UUID, bool, error) {
+	if all, _ := ctx.Value(allTenantsKey{}).(bool); all {
+		return uuid.Nil, false, nil
+	}
+	id, ok := TenantFromContext(ctx)
+	if !ok {
+		return uuid.Nil, false, ErrNoTenant
+	}
+	return id, true, nil
+}
+
+// tenantFilter returns the predicate restricting rows to the tenant of ctx,
+// or nil if ctx spans all tenants.
+func tenantFilter(ctx context.Context) (func(*sql.Selector), error) {
+	id, ok, err := TenantScope(ctx)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return sql.FieldEQ("tenant_id", id), nil
+}
+
+// TenantMixin makes a schema belong to a tenant. Its queries and mutations
+// fail with ErrNoTenant unless the context says which tenant they are for,
+// or that they are for all of them.
+type TenantMixin struct {
+	mixin.Schema
+	// Optional lets rows created for all tenants belong to none, in which
+	// case only queries for all tenants see them.
+	Optional bool
+}
+
+// Fields of the TenantMixin.
+func (m TenantMixin) Fields() []ent.Field {
+	tenant := field.UUID("tenant_id", uuid.UUID{}).Immutable()
+	if m.Optional {
+		tenant.Optional().Nillable()
+	}
+	return []ent.Field{tenant}
+}
+
+// Edges of the TenantMixin.
+func (m TenantMixin) Edges() []ent.Edge {
+	tenant := edge.To("tenant", Tenant.Type).Field("tenant_id").Unique().Immutable()
+	if !m.Optional {
+		tenant.Required()
+	}
+	return []ent.Edge{tenant}
+}
+
+// Indexes of the TenantMixin.
+func (TenantMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+	}
+}
+
+// Interceptors of the TenantMixin.
+func (TenantMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			filter, err := tenantFilter(ctx)
+			if err != nil {
+				return err
+			}
+			if filter != nil {
+				q.WhereP(filter)
+			}
+			return nil
+		}),
+	}
+}
+
+// Hooks of the TenantMixin.
+func (TenantMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				mx, ok := m.(interface {
+					TenantID() (uuid.UUID, bool)
+					SetTenantID(uuid.UUID)
+					WhereP(...func(*sql.Selector))
+				})
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				filter, err := tenantFilter(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if filter == nil {
+					// Creates for all tenants fail on the missing tenant
+					// unless they set it.
+					return next.Mutate(ctx, m)
+				}
+				if !m.Op().Is(ent.OpCreate) {
+					mx.WhereP(filter)
+					return next.Mutate(ctx, m)
+				}
+				tenant, _ := TenantFromContext(ctx)
+				if id, ok := mx.TenantID(); ok && id != tenant {
+					return nil, fmt.Errorf("schema: creating %s for tenant %s in the context of tenant %s", m.Type(), id, tenant)
+				}
+				mx.SetTenantID(tenant)
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
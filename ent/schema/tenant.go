@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Tenant holds the schema definition for the Tenant entity. Users and blogs
+// belong to a tenant, see TenantMixin.
+type Tenant struct {
+	ent.Schema
+}
+
+// Mixin of the Tenant.
+func (Tenant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+	}
+}
+
+// Fields of the Tenant.
+func (Tenant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().Unique(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Hooks of the Tenant.
+func (Tenant) Hooks() []ent.Hook {
+	return []ent.Hook{
+		auditHook,
+	}
+}
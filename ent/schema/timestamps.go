@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"testMigrationEntgo/ent/hook"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TimestampsMixin adds the time a row was created and last updated.
+type TimestampsMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TimestampsMixin.
+func (TimestampsMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now),
+	}
+}
+
+// Hooks of the TimestampsMixin.
+func (TimestampsMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					mx, ok := m.(interface {
+						SetUpdatedAt(time.Time)
+						UpdatedAt() (time.Time, bool)
+					})
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type %T", m)
+					}
+					// Bulk updates included, unless set explicitly.
+					if _, set := mx.UpdatedAt(); !set {
+						mx.SetUpdatedAt(time.Now())
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne,
+		),
+	}
+}
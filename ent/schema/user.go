@@ -1,7 +1,18 @@
 package schema
 
 import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	gen "testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/hook"
+	"testMigrationEntgo/ent/privacy"
+	"testMigrationEntgo/ent/user"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -11,13 +22,26 @@ type User struct {
 	ent.Schema
 }
 
+// Mixin of the User.
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TenantMixin{},
+		TimestampsMixin{},
+		SoftDeleteMixin{},
+		VersionMixin{},
+	}
+}
+
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name"),
-		field.String("email").Unique(),
+		field.String("email").Unique().Validate(validateEmail).
+			Comment("Stored lower case, see NormalizeEmail"),
 		field.String("title").Optional(),
-		field.Int("followers").Optional(),
+		field.Int("legacy_followers").Optional().Immutable().
+			Comment("Follower count kept from before the follows graph existed"),
 	}
 }
 
@@ -25,5 +49,100 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("blog_posts", Blog.Type),
+		edge.To("comments", Comment.Type),
+		edge.To("reactions", Reaction.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("following", User.Type).
+			StorageKey(edge.Columns("follower_id", "followee_id")).
+			Through("follows", Follow.Type),
+		edge.From("followers", User.Type).
+			Ref("following"),
+	}
+}
+
+// Hooks of the User.
+func (User) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (ent.Value, error) {
+					if email, ok := m.Email(); ok {
+						m.SetEmail(NormalizeEmail(email))
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+		),
+		auditHook,
+	}
+}
+
+// Policy of the User: blogs are handed over to an author through the
+// blog_posts edge as well as through the author of the Blog, so that edge is
+// only changed by an admin, or by a viewer on their own blogs and user.
+func (User) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			allowIfAdmin(),
+			blogPostsRule(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
+
+// blogPostsRule allows viewers to add or remove blogs of their own to or
+// from their own user, and denies any other change to the blog_posts edge.
+func blogPostsRule() privacy.MutationRule {
+	return privacy.UserMutationRuleFunc(func(ctx context.Context, m *gen.UserMutation) error {
+		ids := append(m.BlogPostsIDs(), m.RemovedBlogPostsIDs()...)
+		if len(ids) == 0 && !m.BlogPostsCleared() {
+			return privacy.Skip
+		}
+		v, ok := ViewerFromContext(ctx)
+		if !ok {
+			return privacy.Denyf("schema: no viewer in context")
+		}
+		if len(ids) > 0 {
+			own, err := ownsBlogs(ctx, m.Client(), v, ids)
+			if err != nil {
+				return err
+			}
+			if !own {
+				return privacy.Denyf("schema: viewer %s can only author blogs of their own", v.ID)
+			}
+		}
+		switch {
+		case m.Op().Is(ent.OpUpdateOne):
+			if id, _ := m.ID(); id != v.ID {
+				return privacy.Denyf("schema: viewer %s can only change blogs of their own user", v.ID)
+			}
+		case m.Op().Is(ent.OpUpdate):
+			// Rows of a bulk update are not loaded, so it is restricted to
+			// the user of the viewer.
+			m.Where(user.ID(v.ID))
+		default:
+			return privacy.Denyf("schema: viewer %s can only change blogs of their own user", v.ID)
+		}
+		return privacy.Skip
+	})
+}
+
+// NormalizeEmail returns the form emails are stored in: trimmed and lower
+// case, so that addresses differing only by case belong to the same user.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// validateEmail accepts bare addresses, like a@b.c, and rejects anything
+// else mail.ParseAddress accepts, like display names.
+func validateEmail(email string) error {
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return fmt.Errorf("invalid email %q: %w", email, err)
+	}
+	if addr.Address != email || addr.Name != "" {
+		return fmt.Errorf("invalid email %q: only the address is expected", email)
 	}
+	return nil
 }
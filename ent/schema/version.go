@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	gen "testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/hook"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// VersionMixin adds a version incremented by every update, for optimistic
+// locking: updating a single entity only succeeds if it is still at the
+// version it was read at, and fails with a VersionConflictError otherwise.
+// The version of an entity given to UpdateOne is the one it was read at.
+// UpdateOneID loads the current one, in the transaction of the update, so
+// it must be restricted to the expected one with a Where on the version.
+type VersionMixin struct {
+	mixin.Schema
+}
+
+// Fields of the VersionMixin.
+func (VersionMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").Default(1).Positive(),
+	}
+}
+
+// Hooks of the VersionMixin.
+func (VersionMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					mx, ok := m.(interface {
+						OldVersion(context.Context) (int, error)
+						SetVersion(int)
+						AddVersion(int)
+						ResetVersion()
+						WhereP(...func(*sql.Selector))
+					})
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type %T", m)
+					}
+					if m.Op().Is(ent.OpUpdate) {
+						mx.ResetVersion()
+						mx.AddVersion(1)
+						return next.Mutate(ctx, m)
+					}
+					// Deleted entities can be updated, restoring them included.
+					version, err := mx.OldVersion(IncludeDeleted(ctx))
+					if err != nil {
+						return nil, err
+					}
+					mx.WhereP(sql.FieldEQ("version", version))
+					mx.SetVersion(version + 1)
+					v, err := next.Mutate(ctx, m)
+					if gen.IsNotFound(err) {
+						return nil, &gen.VersionConflictError{Type: m.Type(), ID: mutationID(m)}
+					}
+					return v, err
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne,
+		),
+	}
+}
+
+// mutationID returns the id of the entity a mutation changes, if any.
+func mutationID(m ent.Mutation) any {
+	switch m := m.(type) {
+	case interface{ ID() (uuid.UUID, bool) }:
+		id, _ := m.ID()
+		return id
+	case interface{ ID() (int, bool) }:
+		id, _ := m.ID()
+		return id
+	}
+	return nil
+}
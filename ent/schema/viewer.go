@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"context"
+
+	"testMigrationEntgo/ent/privacy"
+
+	"entgo.io/ent"
+	"github.com/google/uuid"
+)
+
+// Viewer is the user on whose behalf mutations run. Whether the user is an
+// admin is up to whoever authenticated them.
+type Viewer struct {
+	ID    uuid.UUID
+	Admin bool
+}
+
+type viewerKey struct{}
+
+// WithViewer returns a context whose mutations run on behalf of v, subject
+// to the privacy policies of the schemas.
+func WithViewer(parent context.Context, v Viewer) context.Context {
+	return context.WithValue(parent, viewerKey{}, v)
+}
+
+// ViewerFromContext returns the viewer set by WithViewer, if any.
+func ViewerFromContext(ctx context.Context) (Viewer, bool) {
+	v, ok := ctx.Value(viewerKey{}).(Viewer)
+	return v, ok
+}
+
+// System returns a context whose queries and mutations bypass the privacy
+// policies, for seeding, migrations and other tasks run on behalf of no one.
+func System(parent context.Context) context.Context {
+	return privacy.DecisionContext(parent, privacy.Allow)
+}
+
+// denyIfNoViewer denies mutations run on behalf of no one outside of System.
+func denyIfNoViewer() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, _ ent.Mutation) error {
+		if _, ok := ViewerFromContext(ctx); !ok {
+			return privacy.Denyf("schema: no viewer in context")
+		}
+		return privacy.Skip
+	})
+}
+
+// allowIfAdmin allows the mutations of admins.
+func allowIfAdmin() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, _ ent.Mutation) error {
+		if v, _ := ViewerFromContext(ctx); v.Admin {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
@@ -0,0 +1,7 @@
+package ent
+
+// NewValidationError returns a ValidationError on the given field or edge.
+// It lets hooks reject mutations the same way generated validators do.
+func NewValidationError(name string, err error) *ValidationError {
+	return &ValidationError{Name: name, err: err}
+}
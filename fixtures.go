@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/schema"
+	"testMigrationEntgo/ent/user"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fixtureExts are the fixture file extensions, in lookup order.
+var fixtureExts = []string{".yaml", ".yml", ".json"}
+
+// fixture is the seed data of a profile.
+type fixture struct {
+	Users []fixtureUser `json:"users" yaml:"users"`
+	Blogs []fixtureBlog `json:"blogs" yaml:"blogs"`
+}
+
+// fixtureUser describes a user. Key is how blogs refer to it and defaults to
+// the email, once normalized.
+type fixtureUser struct {
+	Key   string `json:"key" yaml:"key"`
+	Name  string `json:"name" yaml:"name"`
+	Email string `json:"email" yaml:"email"`
+	Title string `json:"title" yaml:"title"`
+}
+
+// fixtureBlog describes a blog post. Author is the key of a fixture user and
+// Status defaults to published.
+type fixtureBlog struct {
+	Title  string `json:"title" yaml:"title"`
+	Body   string `json:"body" yaml:"body"`
+	Author string `json:"author" yaml:"author"`
+	Status string `json:"status" yaml:"status"`
+}
+
+// loadFixture reads the fixture of the given profile from dir, trying every
+// known extension, and validates it.
+func loadFixture(dir, profile string) (*fixture, error) {
+	for _, ext := range fixtureExts {
+		path := filepath.Join(dir, profile+ext)
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		f, err := parseFixture(data, ext)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing %s: %w", path, err)
+		}
+		if err := f.validate(); err != nil {
+			return nil, fmt.Errorf("invalid fixture %s: %w", path, err)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("no fixture for profile %q in %s (tried %s)", profile, dir, strings.Join(fixtureExts, ", "))
+}
+
+// parseFixture decodes data according to the file extension. Unknown keys are
+// rejected so typos don't silently drop data.
+func parseFixture(data []byte, ext string) (*fixture, error) {
+	f := &fixture{}
+	switch ext {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(f); err != nil {
+			return nil, err
+		}
+	default:
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(f); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// validate checks the fixture against the constraints of the ent schema:
+// required fields, valid and unique emails, valid statuses and blog authors
+// referring to known users.
+func (f *fixture) validate() error {
+	var errs []error
+	keys := make(map[string]bool, len(f.Users))
+	emails := make(map[string]bool, len(f.Users))
+	for i := range f.Users {
+		u := &f.Users[i]
+		u.Email = schema.NormalizeEmail(u.Email)
+		if u.Key == "" {
+			u.Key = u.Email
+		}
+		if u.Name == "" {
+			errs = append(errs, fmt.Errorf("users[%d]: missing name", i))
+		}
+		if u.Email == "" {
+			errs = append(errs, fmt.Errorf("users[%d]: missing email", i))
+		} else if err := user.EmailValidator(u.Email); err != nil {
+			errs = append(errs, fmt.Errorf("users[%d]: %w", i, err))
+		} else if emails[u.Email] {
+			errs = append(errs, fmt.Errorf("users[%d]: duplicate email %q", i, u.Email))
+		}
+		if keys[u.Key] {
+			errs = append(errs, fmt.Errorf("users[%d]: duplicate key %q", i, u.Key))
+		}
+		emails[u.Email], keys[u.Key] = true, true
+	}
+	for i := range f.Blogs {
+		b := &f.Blogs[i]
+		if b.Status == "" {
+			b.Status = blog.StatusPublished.String()
+		}
+		if err := blog.StatusValidator(blog.Status(b.Status)); err != nil {
+			errs = append(errs, fmt.Errorf("blogs[%d]: %w", i, err))
+		}
+		if b.Title == "" {
+			errs = append(errs, fmt.Errorf("blogs[%d]: missing title", i))
+		}
+		if b.Body == "" {
+			errs = append(errs, fmt.Errorf("blogs[%d]: missing body", i))
+		}
+		if b.Author != "" && !keys[b.Author] {
+			errs = append(errs, fmt.Errorf("blogs[%d]: unknown author %q", i, b.Author))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFixtureValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture fixture
+		wantErr []string
+	}{
+		{
+			name: "valid",
+			fixture: fixture{
+				Users: []fixtureUser{{Key: "a", Name: "A", Email: "a@example.com"}, {Name: "B", Email: "B@Example.com"}},
+				Blogs: []fixtureBlog{{Title: "t", Body: "b", Author: "a"}, {Title: "t", Body: "b", Author: "b@example.com", Status: "draft"}},
+			},
+		},
+		{
+			name: "missing fields",
+			fixture: fixture{
+				Users: []fixtureUser{{Key: "a"}},
+				Blogs: []fixtureBlog{{Author: "a"}},
+			},
+			wantErr: []string{"users[0]: missing name", "users[0]: missing email", "blogs[0]: missing title", "blogs[0]: missing body"},
+		},
+		{
+			name: "duplicates",
+			fixture: fixture{
+				Users: []fixtureUser{{Key: "a", Name: "A", Email: "a@example.com"}, {Key: "a", Name: "B", Email: "A@example.com"}},
+			},
+			wantErr: []string{`users[1]: duplicate email "a@example.com"`, `users[1]: duplicate key "a"`},
+		},
+		{
+			name: "invalid references",
+			fixture: fixture{
+				Users: []fixtureUser{{Name: "A", Email: "not an email"}},
+				Blogs: []fixtureBlog{{Title: "t", Body: "b", Author: "nobody", Status: "pending"}},
+			},
+			wantErr: []string{"users[0]:", "blogs[0]:", `blogs[0]: unknown author "nobody"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fixture.validate()
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("got error %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("got no error")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("got error %q, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFixtureValidateDefaults(t *testing.T) {
+	f := fixture{
+		Users: []fixtureUser{{Name: "A", Email: " A@Example.com "}},
+		Blogs: []fixtureBlog{{Title: "t", Body: "b"}},
+	}
+	if err := f.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if u := f.Users[0]; u.Email != "a@example.com" || u.Key != u.Email {
+		t.Errorf("got email %q and key %q, want both normalized", u.Email, u.Key)
+	}
+	if s := f.Blogs[0].Status; s != "published" {
+		t.Errorf("got status %q, want published", s)
+	}
+}
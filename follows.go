@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/follow"
+
+	"github.com/google/uuid"
+)
+
+// errSelfFollow is returned when a user tries to follow themselves
+var errSelfFollow = errors.New("a user cannot follow themselves")
+
+// followUser makes follower follow followee. Following someone already followed
+// is a no-op that keeps the original followed_at.
+func followUser(ctx context.Context, cli *ent.Client, followerID, followeeID uuid.UUID) error {
+	if followerID == followeeID {
+		return errSelfFollow
+	}
+	return cli.Follow.Create().
+		SetFollowerID(followerID).
+		SetFolloweeID(followeeID).
+		OnConflictColumns(follow.FieldFollowerID, follow.FieldFolloweeID).
+		DoNothing().
+		Exec(ctx)
+}
+
+// unfollowUser makes follower stop following followee. It reports whether
+// follower was following followee at all.
+func unfollowUser(ctx context.Context, cli *ent.Client, followerID, followeeID uuid.UUID) (bool, error) {
+	n, err := cli.Follow.Delete().
+		Where(follow.FollowerID(followerID), follow.FolloweeID(followeeID)).
+		Exec(ctx)
+	return n > 0, err
+}
+
+// followerCount derives the follower count of u from the follows graph, on
+// top of the count it had before the graph existed.
+func followerCount(ctx context.Context, u *ent.User) (int, error) {
+	n, err := u.QueryFollowers().Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return u.LegacyFollowers + n, nil
+}
+
+// runFollow implements the follow command
+func runFollow(ctx context.Context, args []string) {
+	runFollowChange(ctx, "follow", args)
+}
+
+// runUnfollow implements the unfollow command
+func runUnfollow(ctx context.Context, args []string) {
+	runFollowChange(ctx, "unfollow", args)
+}
+
+func runFollowChange(ctx context.Context, command string, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet(command, flag.ExitOnError)
+	s.register(fs)
+	email := fs.String("user", "", "email of the user to "+command)
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(withPrimary(ctx), client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	follower := requireViewer(ctx, command)
+	followee, err := userByEmail(ctx, client, *email)
+	if err != nil {
+		log.Fatalf("failed looking up user %s: %v", *email, err)
+	}
+	if command == "follow" {
+		if err := followUser(ctx, client, follower, followee.ID); err != nil {
+			log.Fatalf("failed following %s: %v", *email, err)
+		}
+		log.Printf("following %s", *email)
+		return
+	}
+	followed, err := unfollowUser(ctx, client, follower, followee.ID)
+	if err != nil {
+		log.Fatalf("failed unfollowing %s: %v", *email, err)
+	}
+	if !followed {
+		log.Printf("%s was not followed", *email)
+		return
+	}
+	log.Printf("unfollowed %s", *email)
+}
+
+// runFollowers implements the followers command
+func runFollowers(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("followers", flag.ExitOnError)
+	s.register(fs)
+	email := fs.String("user", "", "email of the user whose followers are counted")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(ctx, client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	u, err := userByEmail(ctx, client, *email)
+	if err != nil {
+		log.Fatalf("failed looking up user %s: %v", *email, err)
+	}
+	n, err := followerCount(ctx, u)
+	if err != nil {
+		log.Fatalf("failed counting followers: %v", err)
+	}
+	fmt.Printf("%s has %d followers\n", *email, n)
+}
@@ -0,0 +1,363 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/google/uuid"
+)
+
+// redactedValue replaces the value of every redacted statement argument.
+const redactedValue = "[REDACTED]"
+
+// queryLogOptions configures a loggingDriver.
+type queryLogOptions struct {
+	// Logger receives the records. Defaults to slog.Default().
+	Logger *slog.Logger
+	// SampleRate is the fraction, between 0 and 1, of successful statements
+	// under the slow threshold that are logged. Slow and failed statements
+	// are always logged.
+	SampleRate float64
+	// SlowThreshold is the duration from which a statement is logged as slow
+	// at warning level. Zero disables slow query detection.
+	SlowThreshold time.Duration
+	// Redact lists the columns whose arguments are never logged, written as
+	// "table.column" or "column" to match the column in every table.
+	Redact []string
+}
+
+// loggingDriver is a dialect.Driver that emits a structured log record for
+// every statement executed through the underlying driver.
+type loggingDriver struct {
+	dialect.Driver
+	logger    *slog.Logger
+	sample    float64
+	slow      time.Duration
+	redactor  *argRedactor
+	randFloat func() float64
+}
+
+// newLoggingDriver wraps drv so every statement is logged according to opts.
+func newLoggingDriver(drv dialect.Driver, opts queryLogOptions) dialect.Driver {
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &loggingDriver{
+		Driver:    drv,
+		logger:    logger,
+		sample:    opts.SampleRate,
+		slow:      opts.SlowThreshold,
+		redactor:  newArgRedactor(opts.Redact),
+		randFloat: rand.Float64,
+	}
+}
+
+// Exec logs and calls the underlying driver Exec method.
+func (d *loggingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.log(ctx, "", "Exec", query, args, func() error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// Query logs and calls the underlying driver Query method.
+func (d *loggingDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.log(ctx, "", "Query", query, args, func() error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// ExecContext logs and calls the underlying driver ExecContext method if it is supported.
+func (d *loggingDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	drv, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	var res sql.Result
+	err := d.log(ctx, "", "ExecContext", query, args, func() (err error) {
+		res, err = drv.ExecContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+// QueryContext logs and calls the underlying driver QueryContext method if it is supported.
+func (d *loggingDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	drv, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	var rows *sql.Rows
+	err := d.log(ctx, "", "QueryContext", query, args, func() (err error) {
+		rows, err = drv.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// Tx starts a transaction whose statements are logged with its id.
+func (d *loggingDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.newTx(ctx, tx), nil
+}
+
+// BeginTx starts a transaction whose statements are logged with its id. It
+// calls the underlying driver BeginTx command if it is supported.
+func (d *loggingDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return d.newTx(ctx, tx), nil
+}
+
+func (d *loggingDriver) newTx(ctx context.Context, tx dialect.Tx) dialect.Tx {
+	id := uuid.New().String()
+	d.logger.DebugContext(ctx, "transaction started", slog.String("tx_id", id))
+	return &loggingTx{Tx: tx, drv: d, id: id, ctx: ctx}
+}
+
+// log runs exec and emits a record about it, unless the statement was fast,
+// successful and not sampled.
+func (d *loggingDriver) log(ctx context.Context, txID, method, query string, args any, exec func() error) error {
+	start := time.Now()
+	err := exec()
+	took := time.Since(start)
+
+	level, msg := slog.LevelDebug, "query"
+	switch {
+	case err != nil:
+		level, msg = slog.LevelError, "query failed"
+	case d.slow > 0 && took >= d.slow:
+		level, msg = slog.LevelWarn, "slow query"
+	case d.randFloat() >= d.sample:
+		return err
+	}
+	if !d.logger.Enabled(ctx, level) {
+		return err
+	}
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("statement", query),
+		slog.Any("args", d.redactor.redact(query, args)),
+		slog.Duration("duration", took),
+	}
+	if txID != "" {
+		attrs = append(attrs, slog.String("tx_id", txID))
+	}
+	if caller, ok := queryCaller(); ok {
+		attrs = append(attrs, slog.String("caller", caller))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	}
+	d.logger.LogAttrs(ctx, level, msg, attrs...)
+	return err
+}
+
+// loggingTx is a dialect.Tx that logs its statements along with its id.
+type loggingTx struct {
+	dialect.Tx
+	drv *loggingDriver
+	id  string
+	ctx context.Context
+}
+
+// Exec logs and calls the underlying transaction Exec method.
+func (t *loggingTx) Exec(ctx context.Context, query string, args, v any) error {
+	return t.drv.log(ctx, t.id, "Tx.Exec", query, args, func() error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+// Query logs and calls the underlying transaction Query method.
+func (t *loggingTx) Query(ctx context.Context, query string, args, v any) error {
+	return t.drv.log(ctx, t.id, "Tx.Query", query, args, func() error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+// Commit logs this step and calls the underlying transaction Commit method.
+func (t *loggingTx) Commit() error {
+	err := t.Tx.Commit()
+	t.drv.logger.DebugContext(t.ctx, "transaction committed", slog.String("tx_id", t.id), slog.Any("error", err))
+	return err
+}
+
+// Rollback logs this step and calls the underlying transaction Rollback method.
+func (t *loggingTx) Rollback() error {
+	err := t.Tx.Rollback()
+	t.drv.logger.DebugContext(t.ctx, "transaction rolled back", slog.String("tx_id", t.id), slog.Any("error", err))
+	return err
+}
+
+// queryCaller returns the first frame on the stack that belongs neither to
+// ent, nor to the generated code, nor to a driver wrapper or a hook in this
+// package. Mutations go through many hooks, hence the deep stack.
+func queryCaller() (string, bool) {
+	pcs := make([]uintptr, 128)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !isInfraFrame(frame.Function) {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line), true
+		}
+		if !more {
+			return "", false
+		}
+	}
+}
+
+// mainPkg prefixes the functions of this package in stack frames: main in
+// the command, and the import path of the package in its tests.
+var mainPkg = reflect.TypeOf(loggingDriver{}).PkgPath() + "."
+
+func isInfraFrame(fn string) bool {
+	for _, prefix := range []string{
+		"entgo.io/",
+		"testMigrationEntgo/ent.",
+		"testMigrationEntgo/ent/",
+		"database/sql.",
+		"runtime.",
+		"sync.",
+	} {
+		if strings.HasPrefix(fn, prefix) {
+			return true
+		}
+	}
+	for _, prefix := range []string{
+		"(*loggingDriver)",
+		"(*loggingTx)",
+		"(*tracingDriver)",
+		"(*tracingTx)",
+		"traceExec",
+		"traceQuery",
+		"tracingHook",
+		"auditHook",
+		"writeAudit",
+		"auditChanges",
+	} {
+		if strings.HasPrefix(fn, mainPkg+prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// insertRe matches the target table, column list and values of an INSERT.
+	insertRe = regexp.MustCompile(`(?is)^\s*INSERT\s+INTO\s+"(\w+)"\s*\(([^)]*)\)\s*VALUES\s*(.*)$`)
+	// tableRe matches the main table of an UPDATE, DELETE or SELECT.
+	tableRe = regexp.MustCompile(`(?i)(?:UPDATE|FROM)\s+"(\w+)"`)
+	// aliasRe matches a table and the alias it is given.
+	aliasRe = regexp.MustCompile(`(?i)"(\w+)"\s+AS\s+"(\w+)"`)
+	// compareRe matches a column compared or assigned to a placeholder, or
+	// to a list of placeholders.
+	compareRe = regexp.MustCompile(`(?i)(?:"(\w+)"\.)?"(\w+)"\s*(?:(?:=|<>|!=|<=|>=|<|>|I?LIKE)\s*(\$\d+)|IN\s*\(\s*(\$\d+(?:\s*,\s*\$\d+)*)\s*\))`)
+	// placeholderRe matches a positional placeholder.
+	placeholderRe = regexp.MustCompile(`\$(\d+)`)
+	// identRe matches a quoted identifier.
+	identRe = regexp.MustCompile(`"(\w+)"`)
+)
+
+// argRedactor hides the arguments bound to sensitive columns.
+type argRedactor struct {
+	columns map[string]bool
+}
+
+func newArgRedactor(columns []string) *argRedactor {
+	r := &argRedactor{columns: make(map[string]bool, len(columns))}
+	for _, c := range columns {
+		r.columns[strings.ToLower(c)] = true
+	}
+	return r
+}
+
+func (r *argRedactor) matches(table, column string) bool {
+	return r.columns[column] || r.columns[table+"."+column]
+}
+
+// redact returns a copy of args where every value bound to a redacted column
+// is replaced. Arguments that cannot be related to a column are kept.
+func (r *argRedactor) redact(query string, args any) any {
+	argv, ok := args.([]any)
+	if !ok || len(r.columns) == 0 || len(argv) == 0 {
+		return args
+	}
+	redacted := make(map[int]bool)
+	if m := insertRe.FindStringSubmatch(query); m != nil {
+		table := strings.ToLower(m[1])
+		var cols []string
+		for _, c := range identRe.FindAllStringSubmatch(m[2], -1) {
+			cols = append(cols, strings.ToLower(c[1]))
+		}
+		if len(cols) > 0 {
+			// Bulk inserts repeat the column list once per row.
+			for i, p := range placeholderRe.FindAllStringSubmatch(m[3], -1) {
+				if n, err := strconv.Atoi(p[1]); err == nil && r.matches(table, cols[i%len(cols)]) {
+					redacted[n-1] = true
+				}
+			}
+		}
+	}
+	table := ""
+	if m := tableRe.FindStringSubmatch(query); m != nil {
+		table = strings.ToLower(m[1])
+	}
+	aliases := make(map[string]string)
+	for _, m := range aliasRe.FindAllStringSubmatch(query, -1) {
+		aliases[strings.ToLower(m[2])] = strings.ToLower(m[1])
+	}
+	for _, m := range compareRe.FindAllStringSubmatch(query, -1) {
+		t := table
+		if m[1] != "" {
+			t = strings.ToLower(m[1])
+			if aliased, ok := aliases[t]; ok {
+				t = aliased
+			}
+		}
+		if !r.matches(t, strings.ToLower(m[2])) {
+			continue
+		}
+		for _, p := range placeholderRe.FindAllStringSubmatch(m[3]+m[4], -1) {
+			if n, err := strconv.Atoi(p[1]); err == nil {
+				redacted[n-1] = true
+			}
+		}
+	}
+	if len(redacted) == 0 {
+		return args
+	}
+	out := make([]any, len(argv))
+	for i, a := range argv {
+		if redacted[i] {
+			a = redactedValue
+		}
+		out[i] = a
+	}
+	return out
+}
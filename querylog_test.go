@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/schema"
+)
+
+func TestArgRedactorRedact(t *testing.T) {
+	r := newArgRedactor([]string{"email", "users.password"})
+	const x = redactedValue
+	tests := []struct {
+		name  string
+		query string
+		args  []any
+		want  []any
+	}{
+		{
+			name:  "insert",
+			query: `INSERT INTO "users" ("name", "email", "password") VALUES ($1, $2, $3)`,
+			args:  []any{"a", "a@x", "secret"},
+			want:  []any{"a", x, x},
+		},
+		{
+			name:  "bulk insert",
+			query: `INSERT INTO "users" ("name", "email") VALUES ($1, $2), ($3, $4)`,
+			args:  []any{"a", "a@x", "b", "b@x"},
+			want:  []any{"a", x, "b", x},
+		},
+		{
+			name:  "update",
+			query: `UPDATE "users" SET "name" = $1, "password" = $2 WHERE "id" = $3`,
+			args:  []any{"a", "secret", 1},
+			want:  []any{"a", x, 1},
+		},
+		{
+			name:  "qualified comparison",
+			query: `SELECT "users"."id" FROM "users" WHERE "users"."email" = $1 AND "users"."name" LIKE $2`,
+			args:  []any{"a@x", "a%"},
+			want:  []any{x, "a%"},
+		},
+		{
+			name:  "in list",
+			query: `SELECT "users"."id" FROM "users" WHERE "users"."email" IN ($1, $2, $3) LIMIT $4`,
+			args:  []any{"a@x", "b@x", "c@x", 10},
+			want:  []any{x, x, x, 10},
+		},
+		{
+			name:  "alias",
+			query: `SELECT "blogs"."id" FROM "blogs" JOIN (SELECT "t1"."id" FROM "users" AS "t1" WHERE "t1"."password" = $1) AS "t2" ON "blogs"."user_blog_posts" = "t2"."id" WHERE "blogs"."title" = $2`,
+			args:  []any{"secret", "title"},
+			want:  []any{x, "title"},
+		},
+		{
+			name:  "column of another table",
+			query: `UPDATE "tenants" SET "password" = $1`,
+			args:  []any{"kept"},
+			want:  []any{"kept"},
+		},
+		{
+			name:  "unrelated placeholders",
+			query: `SELECT "users"."id" FROM "users" WHERE "users"."name" = $1 LIMIT $2`,
+			args:  []any{"a", 1},
+			want:  []any{"a", 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.redact(tt.query, tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryCaller(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	drv := newLoggingDriver(openTestDriver(t), queryLogOptions{Logger: logger, SampleRate: 1})
+	cli := newTestClient(t, drv)
+	ctx := testTenant(t, cli, "t")
+	a := testUser(t, ctx, cli, "a@example.com")
+	cli.Use(auditHook())
+
+	// Queries made by hooks, audit logs included, are attributed to the
+	// code running the mutation.
+	buf.Reset()
+	err := inTx(ctx, cli, func(cli *ent.Client) error {
+		return cli.User.UpdateOneID(a.ID).SetName("renamed").Exec(schema.System(ctx))
+	})
+	if err != nil {
+		t.Fatalf("updating user: %v", err)
+	}
+	var n int
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry struct {
+			Msg       string `json:"msg"`
+			Statement string `json:"statement"`
+			Caller    string `json:"caller"`
+		}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("decoding log %q: %v", line, err)
+		}
+		if entry.Statement == "" {
+			continue
+		}
+		n++
+		if file, _, _ := strings.Cut(filepath.Base(entry.Caller), ":"); file != "querylog_test.go" {
+			t.Errorf("caller of %q: got %s, want querylog_test.go", entry.Statement, entry.Caller)
+		}
+	}
+	if n == 0 {
+		t.Error("no query logged")
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/reaction"
+
+	"github.com/google/uuid"
+)
+
+// toggleReaction adds the reaction of a user to a blog, or removes it if
+// the user already reacted with that kind. It reports whether the reaction
+// was added.
+func toggleReaction(ctx context.Context, cli *ent.Client, userID, blogID uuid.UUID, kind reaction.Kind) (added bool, err error) {
+	err = inTx(ctx, cli, func(cli *ent.Client) error {
+		n, err := cli.Reaction.Delete().
+			Where(reaction.UserID(userID), reaction.BlogID(blogID), reaction.KindEQ(kind)).
+			Exec(ctx)
+		if err != nil || n > 0 {
+			return err
+		}
+		// A concurrent toggle may have added the reaction in the meantime,
+		// in which case it is kept, and doing nothing on conflict returns
+		// no rows.
+		err = cli.Reaction.Create().
+			SetUserID(userID).
+			SetBlogID(blogID).
+			SetKind(kind).
+			OnConflictColumns(reaction.FieldUserID, reaction.FieldBlogID, reaction.FieldKind).
+			DoNothing().
+			Exec(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+		}
+		added = err == nil
+		return err
+	})
+	return added, err
+}
+
+// reactionCounts is the number of reactions of each kind to a blog.
+type reactionCounts map[reaction.Kind]int
+
+func (c reactionCounts) String() string {
+	var parts []string
+	for _, kind := range []reaction.Kind{reaction.KindLike, reaction.KindLove, reaction.KindLaugh, reaction.KindWow, reaction.KindSad} {
+		if n := c[kind]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%s:%d", kind, n))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// blogReactions is a blog along with its reaction counts.
+type blogReactions struct {
+	*ent.Blog
+	Reactions reactionCounts
+}
+
+// withReactionCounts runs q and returns its blogs along with their reaction
+// counts, which are aggregated by a single query for all the blogs.
+func withReactionCounts(ctx context.Context, cli *ent.Client, q *ent.BlogQuery) ([]blogReactions, error) {
+	blogs, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, len(blogs))
+	result := make([]blogReactions, len(blogs))
+	byID := make(map[uuid.UUID]reactionCounts, len(blogs))
+	for i, b := range blogs {
+		ids[i] = b.ID
+		result[i] = blogReactions{Blog: b, Reactions: reactionCounts{}}
+		byID[b.ID] = result[i].Reactions
+	}
+	if len(ids) == 0 {
+		return result, nil
+	}
+	var counts []struct {
+		BlogID uuid.UUID     `json:"blog_id"`
+		Kind   reaction.Kind `json:"kind"`
+		Count  int           `json:"count"`
+	}
+	err = cli.Reaction.Query().
+		Where(reaction.BlogIDIn(ids...)).
+		GroupBy(reaction.FieldBlogID, reaction.FieldKind).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range counts {
+		byID[c.BlogID][c.Kind] = c.Count
+	}
+	return result, nil
+}
+
+// runReact implements the react command, toggling a reaction to a blog
+func runReact(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("react", flag.ExitOnError)
+	s.register(fs)
+	blogID := uuidFlag(fs, "id", "id of the blog to react to")
+	kind := fs.String("kind", string(reaction.KindLike), "kind of the reaction")
+	fs.Parse(args)
+
+	if err := reaction.KindValidator(reaction.Kind(*kind)); err != nil {
+		log.Fatalf("invalid kind %q", *kind)
+	}
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(withPrimary(ctx), client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	added, err := toggleReaction(ctx, client, requireViewer(ctx, "react"), *blogID, reaction.Kind(*kind))
+	if err != nil {
+		log.Fatalf("failed reacting: %v", err)
+	}
+	if added {
+		log.Printf("reacted with %s", *kind)
+		return
+	}
+	log.Printf("removed %s reaction", *kind)
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"testing"
+
+	"testMigrationEntgo/ent/reaction"
+	"testMigrationEntgo/ent/schema"
+)
+
+func TestToggleReaction(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := testTenant(t, cli, "t")
+	author := testUser(t, ctx, cli, "a@example.com")
+	b := testBlog(t, ctx, cli, author, "post")
+	cli.Use(auditHook())
+	ctx = schema.System(ctx)
+
+	for i, want := range []bool{true, false, true} {
+		added, err := toggleReaction(ctx, cli, author.ID, b.ID, reaction.KindLike)
+		if err != nil {
+			t.Fatalf("toggle %d: %v", i, err)
+		}
+		if added != want {
+			t.Errorf("toggle %d: got added %t, want %t", i, added, want)
+		}
+	}
+	blogs, err := withReactionCounts(ctx, cli, cli.Blog.Query())
+	if err != nil {
+		t.Fatalf("withReactionCounts: %v", err)
+	}
+	if got := blogs[0].Reactions.String(); got != "like:1" {
+		t.Errorf("reaction counts: got %q, want %q", got, "like:1")
+	}
+}
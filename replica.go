@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync/atomic"
+
+	"entgo.io/ent/dialect"
+)
+
+// primaryKey is the context key that forces reads to the primary.
+type primaryKey struct{}
+
+// withPrimary returns a context whose reads are served by the primary, so
+// data written just before is visible regardless of replication lag.
+func withPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// usePrimary reports whether ctx was created by withPrimary.
+func usePrimary(ctx context.Context) bool {
+	v, _ := ctx.Value(primaryKey{}).(bool)
+	return v
+}
+
+// Statements safe to send to a replica are plain SELECTs that do not lock rows.
+var (
+	selectRe  = regexp.MustCompile(`(?is)^\s*SELECT\b`)
+	lockingRe = regexp.MustCompile(`(?is)\bFOR\s+(?:NO\s+KEY\s+)?(?:UPDATE|SHARE)\b`)
+)
+
+// replicaDriver is a dialect.Driver that sends reads to the replicas, in a
+// round-robin fashion, and mutations and transactions to the primary.
+type replicaDriver struct {
+	primary  dialect.Driver
+	replicas []dialect.Driver
+	next     atomic.Uint64
+}
+
+// newReplicaDriver returns a driver routing reads to replicas. If no replica
+// is given, every statement goes to the primary.
+func newReplicaDriver(primary dialect.Driver, replicas ...dialect.Driver) dialect.Driver {
+	if len(replicas) == 0 {
+		return primary
+	}
+	return &replicaDriver{primary: primary, replicas: replicas}
+}
+
+// Exec calls the primary Exec method.
+func (d *replicaDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.primary.Exec(ctx, query, args, v)
+}
+
+// Query calls the Query method of a replica if the statement is read-only and
+// the context does not force the primary.
+func (d *replicaDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.reader(ctx, query).Query(ctx, query, args, v)
+}
+
+// ExecContext calls the primary ExecContext method if it is supported.
+func (d *replicaDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	drv, ok := d.primary.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return drv.ExecContext(ctx, query, args...)
+}
+
+// QueryContext calls the QueryContext method of the driver Query would use,
+// if it is supported.
+func (d *replicaDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	drv, ok := d.reader(ctx, query).(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return drv.QueryContext(ctx, query, args...)
+}
+
+// Tx starts a transaction on the primary.
+func (d *replicaDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.primary.Tx(ctx)
+}
+
+// BeginTx starts a transaction on the primary if it supports BeginTx.
+func (d *replicaDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.primary.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	return drv.BeginTx(ctx, opts)
+}
+
+// Close closes the primary and all replicas.
+func (d *replicaDriver) Close() error {
+	errs := []error{d.primary.Close()}
+	for _, r := range d.replicas {
+		errs = append(errs, r.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// Dialect returns the dialect of the primary.
+func (d *replicaDriver) Dialect() string {
+	return d.primary.Dialect()
+}
+
+// reader returns the driver that should serve the given statement.
+func (d *replicaDriver) reader(ctx context.Context, query string) dialect.Driver {
+	if usePrimary(ctx) || !selectRe.MatchString(query) || lockingRe.MatchString(query) {
+		return d.primary
+	}
+	return d.replicas[(d.next.Add(1)-1)%uint64(len(d.replicas))]
+}
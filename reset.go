@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/migrate"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// migrationsDir holds the versioned migrations applied by atlas
+const migrationsDir = "ent/migrate/migrations"
+
+// productionEnvs are the values of APP_ENV on which reset always refuses to run
+var productionEnvs = []string{"prod", "production"}
+
+// checkNotProduction returns an error if cfg or the environment look like
+// production: APP_ENV says so, or the host or database name mentions it.
+func checkNotProduction(cfg *pgx.ConnConfig) error {
+	env := strings.ToLower(os.Getenv("APP_ENV"))
+	for _, p := range productionEnvs {
+		if env == p {
+			return fmt.Errorf("refusing to run with APP_ENV=%s", env)
+		}
+	}
+	for _, s := range []string{cfg.Host, cfg.Database} {
+		if strings.Contains(strings.ToLower(s), "prod") {
+			return fmt.Errorf("refusing to run on what looks like a production database (%s/%s)", cfg.Host, cfg.Database)
+		}
+	}
+	return nil
+}
+
+// confirm asks the user to type the database name before going on
+func confirm(database string) bool {
+	fmt.Printf("This will delete every row in database %q. Type its name to confirm: ", database)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	return err == nil && strings.TrimSpace(answer) == database
+}
+
+// truncate empties every table of the ent schema and restarts their identities
+func truncate(ctx context.Context, cli *ent.Client) error {
+	names := make([]string, len(migrate.Tables))
+	for i, t := range migrate.Tables {
+		names[i] = strconv.Quote(t.Name)
+	}
+	_, err := cli.ExecContext(ctx, "TRUNCATE TABLE "+strings.Join(names, ", ")+" RESTART IDENTITY CASCADE")
+	return err
+}
+
+// dropSchema drops and recreates the public schema, and drops the atlas
+// revisions wherever atlas stored them
+func dropSchema(ctx context.Context, cli *ent.Client) error {
+	for _, stmt := range []string{
+		"DROP SCHEMA IF EXISTS atlas_schema_revisions CASCADE",
+		"DROP SCHEMA public CASCADE",
+		"CREATE SCHEMA public",
+	} {
+		if _, err := cli.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// atlasURL builds the URL form of the connection string that atlas expects
+func atlasURL(cfg *pgx.ConnConfig) string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+		Path:     cfg.Database,
+		RawQuery: "sslmode=disable&search_path=public",
+	}
+	return u.String()
+}
+
+// applyMigrations applies every versioned migration with the atlas CLI, so
+// the revisions table stays consistent with later `atlas migrate apply` runs
+func applyMigrations(ctx context.Context, dbURL string) error {
+	cmd := exec.CommandContext(ctx, "atlas", "migrate", "apply",
+		"--dir", "file://"+migrationsDir,
+		"--url", dbURL,
+	)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	return cmd.Run()
+}
+
+// runReset implements the reset command
+func runReset(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("reset", flag.ExitOnError)
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	migrations := fs.Bool("migrate", false, "drop the schema and re-run all migrations from scratch instead of truncating")
+	profile := fs.String("profile", defaultProfile, "fixture profile to seed after the reset, empty to skip seeding")
+	migrateURL := fs.String("atlas-url", "", "database URL given to atlas, derived from the connection string by default")
+	tenantName := fs.String("tenant", defaultTenant, "tenant to seed after the reset")
+	fs.Parse(args)
+
+	cfg, err := pgx.ParseConfig(primaryConnStr)
+	if err != nil {
+		log.Fatalf("failed parsing connection string: %v", err)
+	}
+	if err := checkNotProduction(cfg); err != nil {
+		log.Fatal(err)
+	}
+	var f *fixture
+	if *profile != "" {
+		if f, err = loadFixture(fixturesDir, *profile); err != nil {
+			log.Fatalf("failed loading fixture: %v", err)
+		}
+	}
+	if !*yes && !confirm(cfg.Database) {
+		log.Fatal("reset aborted")
+	}
+
+	client := connect(ctx)
+	defer client.Close()
+
+	if *migrations {
+		if err := dropSchema(ctx, client); err != nil {
+			log.Fatalf("failed dropping schema: %v", err)
+		}
+		if *migrateURL == "" {
+			*migrateURL = atlasURL(cfg)
+		}
+		if err := applyMigrations(ctx, *migrateURL); err != nil {
+			log.Fatalf("failed applying migrations: %v", err)
+		}
+		log.Print("schema recreated from migrations")
+	} else {
+		if err := truncate(ctx, client); err != nil {
+			log.Fatalf("failed truncating tables: %v", err)
+		}
+		log.Print("tables truncated")
+	}
+
+	if f == nil {
+		return
+	}
+	// The tenant is created again, as the reset removed it
+	ctx, err = tenantContext(withPrimary(ctx), client, *tenantName)
+	if err != nil {
+		log.Fatalf("failed setting up tenant: %v", err)
+	}
+	report, err := seedTx(ctx, client, f, false)
+	if err != nil {
+		log.Fatalf("failed seeding data, nothing was written (would have written %v): %v", report, err)
+	}
+	log.Printf("seeding done: %v", report)
+}
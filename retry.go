@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"time"
+)
+
+// backoffOptions configures how connecting to the database is retried.
+type backoffOptions struct {
+	// Initial is the delay before the second attempt.
+	Initial time.Duration
+	// Max caps the delay between two attempts.
+	Max time.Duration
+	// Multiplier grows the delay after every failed attempt.
+	Multiplier float64
+	// Jitter is the fraction, between 0 and 1, of every delay that is
+	// randomized so that replicas of a service don't retry in lockstep.
+	Jitter float64
+	// MaxWait is the total time after which connecting is given up.
+	MaxWait time.Duration
+}
+
+// delay returns the delay to wait after the given failed attempt, starting at 1.
+func (o backoffOptions) delay(attempt int) time.Duration {
+	d := float64(o.Initial)
+	for i := 1; i < attempt && d < float64(o.Max); i++ {
+		d *= o.Multiplier
+	}
+	if d > float64(o.Max) {
+		d = float64(o.Max)
+	}
+	// Spread the delay over [d*(1-Jitter), d*(1+Jitter)]
+	d += d * o.Jitter * (2*rand.Float64() - 1)
+	return time.Duration(d)
+}
+
+// waitForDB pings db until it answers, waiting an exponentially growing delay
+// between attempts. sql.Open never connects, so without it an unreachable
+// database is only noticed by the first statement.
+func waitForDB(ctx context.Context, db *sql.DB, name string, opts backoffOptions) error {
+	ctx, cancel := context.WithTimeout(ctx, opts.MaxWait)
+	defer cancel()
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		err := db.PingContext(ctx)
+		if err == nil {
+			slog.Info("connected to database", "database", name, "attempts", attempt, "elapsed", time.Since(start))
+			return nil
+		}
+		wait := opts.delay(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return fmt.Errorf("database %s not reachable after %d attempts in %s: %w", name, attempt, time.Since(start).Round(time.Millisecond), err)
+		}
+		slog.Warn("database not reachable, retrying", "database", name, "attempt", attempt, "retry_in", wait, "error", err)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("database %s not reachable after %d attempts: %w", name, attempt, err)
+		case <-time.After(wait):
+		}
+	}
+}
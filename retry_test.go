@@ -0,0 +1,35 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	opts := backoffOptions{Initial: 100 * time.Millisecond, Max: time.Second, Multiplier: 2}
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{50, time.Second},
+	}
+	for _, tt := range tests {
+		if got := opts.delay(tt.attempt); got != tt.want {
+			t.Errorf("delay(%d) = %s, want %s", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestBackoffDelayJitter(t *testing.T) {
+	opts := backoffOptions{Initial: time.Second, Max: time.Second, Multiplier: 2, Jitter: 0.25}
+	for i := 0; i < 100; i++ {
+		if got := opts.delay(1); got < 750*time.Millisecond || got > 1250*time.Millisecond {
+			t.Fatalf("delay(1) = %s, want within 25%% of 1s", got)
+		}
+	}
+}
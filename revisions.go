@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+
+	"github.com/google/uuid"
+)
+
+// listRevisions returns the revisions of a blog, oldest first.
+func listRevisions(ctx context.Context, cli *ent.Client, blogID uuid.UUID) ([]*ent.BlogRevision, error) {
+	return cli.BlogRevision.Query().
+		Where(blogrevision.BlogID(blogID)).
+		Order(ent.Asc(blogrevision.FieldNumber)).
+		All(ctx)
+}
+
+// getRevision returns the revision of a blog with the given number.
+func getRevision(ctx context.Context, cli *ent.Client, blogID uuid.UUID, number int) (*ent.BlogRevision, error) {
+	return cli.BlogRevision.Query().
+		Where(blogrevision.BlogID(blogID), blogrevision.Number(number)).
+		Only(ctx)
+}
+
+// diffRevisions returns a line diff, in the style of diff -u, going from the
+// revision numbered from to the one numbered to. The title is compared as
+// the first line.
+func diffRevisions(ctx context.Context, cli *ent.Client, blogID uuid.UUID, from, to int) (string, error) {
+	a, err := getRevision(ctx, cli, blogID, from)
+	if err != nil {
+		return "", fmt.Errorf("while loading revision %d: %w", from, err)
+	}
+	b, err := getRevision(ctx, cli, blogID, to)
+	if err != nil {
+		return "", fmt.Errorf("while loading revision %d: %w", to, err)
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- revision %d\n+++ revision %d\n", a.Number, b.Number)
+	for _, l := range diffLines(revisionLines(a), revisionLines(b)) {
+		sb.WriteString(l)
+		sb.WriteByte('\n')
+	}
+	return sb.String(), nil
+}
+
+// restoreRevision sets the title and body of a blog back to the ones of an
+// older revision, which stores them again as its latest revision. The blog
+// must still be at the given version, as for editBlog.
+func restoreRevision(ctx context.Context, cli *ent.Client, blogID uuid.UUID, version, number int) (*ent.Blog, error) {
+	return inTxEntity(ctx, cli, func(cli *ent.Client) (*ent.Blog, error) {
+		r, err := getRevision(ctx, cli, blogID, number)
+		if err != nil {
+			return nil, err
+		}
+		return cli.Blog.UpdateOneID(blogID).
+			Where(blog.Version(version)).
+			SetTitle(r.Title).
+			SetBody(r.Body).
+			Save(ctx)
+	})
+}
+
+func revisionLines(r *ent.BlogRevision) []string {
+	return append([]string{r.Title}, strings.Split(r.Body, "\n")...)
+}
+
+// diffLines compares a and b line by line, using their longest common
+// subsequence, and returns every line prefixed by " " when kept, "-" when
+// removed and "+" when added.
+func diffLines(a, b []string) []string {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var lines []string
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, " "+a[i])
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, "-"+a[i])
+			i++
+		default:
+			lines = append(lines, "+"+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, "-"+a[i])
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, "+"+b[j])
+	}
+	return lines
+}
+
+// runRevisions implements the revisions command, listing the revisions of a
+// blog
+func runRevisions(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("revisions", flag.ExitOnError)
+	s.register(fs)
+	id := uuidFlag(fs, "id", "id of the blog")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(ctx, client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	revisions, err := listRevisions(ctx, client, *id)
+	if err != nil {
+		log.Fatalf("failed listing revisions: %v", err)
+	}
+	for _, r := range revisions {
+		fmt.Printf("%d\t%s\t%s\n", r.Number, r.CreatedAt.Format(time.RFC3339), r.Title)
+	}
+}
+
+// runDiff implements the diff command, comparing two revisions of a blog
+func runDiff(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	s.register(fs)
+	id := uuidFlag(fs, "id", "id of the blog")
+	from := fs.Int("from", 1, "number of the revision to compare from")
+	to := fs.Int("to", 2, "number of the revision to compare to")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(ctx, client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	diff, err := diffRevisions(ctx, client, *id, *from, *to)
+	if err != nil {
+		log.Fatalf("failed comparing revisions: %v", err)
+	}
+	fmt.Print(diff)
+}
+
+// runRestoreRevision implements the restore-revision command
+func runRestoreRevision(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("restore-revision", flag.ExitOnError)
+	s.register(fs)
+	id := uuidFlag(fs, "id", "id of the blog")
+	version := fs.Int("version", 0, "version of the blog the restore was decided at")
+	number := fs.Int("number", 0, "number of the revision to restore")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(withPrimary(ctx), client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	b, err := restoreRevision(ctx, client, *id, *version, *number)
+	if err != nil {
+		log.Fatalf("failed restoring revision %d: %v", *number, err)
+	}
+	log.Printf("restored revision %d of blog %s, now at version %d", *number, b.ID, b.Version)
+}
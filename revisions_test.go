@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/schema"
+)
+
+// revisionNumbers returns the numbers of the revisions of every blog.
+func revisionNumbers(t *testing.T, ctx context.Context, cli *ent.Client) map[string][]int {
+	t.Helper()
+	revisions, err := cli.BlogRevision.Query().
+		WithBlog().
+		Order(ent.Asc(blogrevision.FieldNumber)).
+		All(schema.IncludeDeleted(ctx))
+	if err != nil {
+		t.Fatalf("listing revisions: %v", err)
+	}
+	numbers := make(map[string][]int)
+	for _, r := range revisions {
+		numbers[r.Edges.Blog.Title] = append(numbers[r.Edges.Blog.Title], r.Number)
+	}
+	return numbers
+}
+
+func TestRevisionHook(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := schema.System(testTenant(t, cli, "t"))
+	author := testUser(t, ctx, cli, "a@example.com")
+	a := testBlog(t, ctx, cli, author, "a")
+	testBlog(t, ctx, cli, author, "b")
+
+	if _, err := editBlog(ctx, cli, a.ID, a.Version, "a", "edited"); err != nil {
+		t.Fatalf("editBlog: %v", err)
+	}
+	// Blogs whose body doesn't change get no revision.
+	if err := cli.Blog.Update().SetBody("edited").Exec(ctx); err != nil {
+		t.Fatalf("updating blogs: %v", err)
+	}
+	want := map[string][]int{"a": {1, 2}, "b": {1, 2}}
+	if got := revisionNumbers(t, ctx, cli); !reflect.DeepEqual(got, want) {
+		t.Errorf("revisions: got %v, want %v", got, want)
+	}
+}
+
+func TestGenerateRevisions(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := testTenant(t, cli, "t")
+	opts := synthOptions{Users: 3, BlogsPerUser: 2, BatchSize: 2, Seed: 1, Span: time.Hour, Now: time.Now()}
+	if err := generate(ctx, cli, opts); err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	ctx = schema.System(ctx)
+	blogs, err := cli.Blog.Query().Where(blog.HasRevisions()).Count(ctx)
+	if err != nil {
+		t.Fatalf("counting blogs: %v", err)
+	}
+	revisions, err := cli.BlogRevision.Query().Where(blogrevision.Number(1)).Count(ctx)
+	if err != nil {
+		t.Fatalf("counting revisions: %v", err)
+	}
+	if total := cli.Blog.Query().CountX(ctx); blogs != total || revisions != total {
+		t.Errorf("got %d blogs with revisions and %d first revisions, want %d", blogs, revisions, total)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "equal",
+			a:    []string{"a", "b"},
+			b:    []string{"a", "b"},
+			want: []string{" a", " b"},
+		},
+		{
+			name: "added",
+			b:    []string{"a", "b"},
+			want: []string{"+a", "+b"},
+		},
+		{
+			name: "removed",
+			a:    []string{"a", "b"},
+			want: []string{"-a", "-b"},
+		},
+		{
+			name: "changed line",
+			a:    []string{"title", "one", "two", "three"},
+			b:    []string{"title", "one", "2", "three"},
+			want: []string{" title", " one", "-two", "+2", " three"},
+		},
+		{
+			name: "moved line",
+			a:    []string{"a", "b", "c"},
+			b:    []string{"b", "c", "a"},
+			want: []string{"-a", " b", " c", "+a"},
+		},
+		{
+			name: "insertions and deletions",
+			a:    []string{"a", "b", "c", "d"},
+			b:    []string{"x", "a", "c", "d", "y"},
+			want: []string{"+x", " a", "-b", " c", " d", "+y"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffLines(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
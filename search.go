@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// The search column of blogs is a tsvector generated by postgres from the
+// title and body, see the add_blog_search migration. It is not part of the
+// ent schema, so searching only works on databases set up by migrations.
+const (
+	searchColumn = "search"
+	searchConfig = "'english'"
+	// searchHeadline configures the snippets of search results.
+	searchHeadline = "'StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2'"
+)
+
+// tsquery writes the text search query parsed from a search string, which
+// supports quoted phrases, or and - as web search engines do.
+func tsquery(b *sql.Builder, query string) {
+	b.WriteString("websearch_to_tsquery(" + searchConfig + ", ").Arg(query).WriteString(")")
+}
+
+// matchesSearch matches the blogs whose title or body match a search string.
+// It uses the GIN index on the search column, unlike blog.BodyContains.
+func matchesSearch(query string) predicate.Blog {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString(s.C(searchColumn) + " @@ ")
+			tsquery(b, query)
+		}))
+	}
+}
+
+// searchResult is a blog matching a search, along with its rank and the
+// matching parts of its title and body, highlighted with <mark> tags.
+type searchResult struct {
+	*ent.Blog
+	Rank         float64
+	TitleSnippet string
+	BodySnippet  string
+}
+
+// searchBlogs returns the blogs of q matching a search string, best ranked
+// first, along with highlighted snippets.
+func searchBlogs(ctx context.Context, q *ent.BlogQuery, query string) ([]searchResult, error) {
+	blogs, err := q.Where(matchesSearch(query)).
+		Modify(func(s *sql.Selector) {
+			rank := sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("ts_rank(" + s.C(searchColumn) + ", ")
+				tsquery(b, query)
+				b.WriteString(")")
+			})
+			headline := func(column string) sql.Querier {
+				return sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString("ts_headline(" + searchConfig + ", " + s.C(column) + ", ")
+					tsquery(b, query)
+					b.WriteString(", " + searchHeadline + ")")
+				})
+			}
+			s.AppendSelectExprAs(rank, "rank").
+				AppendSelectExprAs(headline(blog.FieldTitle), "title_snippet").
+				AppendSelectExprAs(headline(blog.FieldBody), "body_snippet").
+				OrderExprFunc(func(b *sql.Builder) {
+					b.Join(rank).WriteString(" DESC, " + s.C(blog.FieldID))
+				})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]searchResult, len(blogs))
+	for i, b := range blogs {
+		results[i] = searchResult{Blog: b}
+		if err := scanSearchValues(b, &results[i]); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// scanSearchValues copies the values selected by searchBlogs into r.
+func scanSearchValues(b *ent.Blog, r *searchResult) error {
+	rank, err := b.Value("rank")
+	if err != nil {
+		return err
+	}
+	title, err := b.Value("title_snippet")
+	if err != nil {
+		return err
+	}
+	body, err := b.Value("body_snippet")
+	if err != nil {
+		return err
+	}
+	var ok bool
+	if r.Rank, ok = rank.(float64); !ok {
+		return fmt.Errorf("unexpected rank type %T", rank)
+	}
+	r.TitleSnippet, r.BodySnippet = snippet(title), snippet(body)
+	return nil
+}
+
+func snippet(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	}
+	return ""
+}
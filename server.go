@@ -3,102 +3,361 @@ package main
 import (
 	"context"
 	"database/sql"
+	"flag"
 	"fmt"
 	"log"
+	"os"
+	"strings"
 	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	_ "testMigrationEntgo/ent/runtime"
+	"testMigrationEntgo/ent/schema"
+	"testMigrationEntgo/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
 var (
-	pgDriver = "pgx"
-	seedInfo = []struct {
-		Name  string
-		Email string
-		Title string
-		Blogs int
-	}{
-		{
-			Name:  "User1",
-			Email: "user1@gmail.com",
-			Title: "User 1 title",
-			Blogs: 2,
-		},
-		{
-			Name:  "User2",
-			Email: "user2@hotmail.com",
-			Blogs: 1,
-		},
-		{
-			Name:  "User3",
-			Email: "user3@yahoo.com",
-			Title: "User 3 title",
-			Blogs: 2,
-		},
+	pgDriver       = "pgx"
+	primaryConnStr = "host=localhost port=5432 user=testuser dbname=test_migration password=testpswd"
+	queryLog       = queryLogOptions{
+		SampleRate:    1,
+		SlowThreshold: 200 * time.Millisecond,
+		Redact:        []string{"users.email"},
 	}
+	connectBackoff = backoffOptions{
+		Initial:    500 * time.Millisecond,
+		Max:        10 * time.Second,
+		Multiplier: 2,
+		Jitter:     0.2,
+		MaxWait:    2 * time.Minute,
+	}
+	// Seed data is read from <fixturesDir>/<profile>.{yaml,yml,json}
+	fixturesDir    = "fixtures"
+	defaultProfile = "dev"
 )
 
-// Gets a new entgo client to a database
-func getClient(connStr string) (*ent.Client, error) {
-	// Open Database
+// Gets a new entgo client to a database. Reads are spread over the replicas,
+// if any, and everything else goes to the primary at connStr.
+func getClient(ctx context.Context, connStr string, replicaConnStrs ...string) (*ent.Client, error) {
+	// Open Databases
+	primary, err := openDriver(ctx, "primary", connStr)
+	if err != nil {
+		return nil, err
+	}
+	replicas := make([]dialect.Driver, 0, len(replicaConnStrs))
+	for i, rc := range replicaConnStrs {
+		replica, err := openDriver(ctx, fmt.Sprintf("replica %d", i+1), rc)
+		if err != nil {
+			primary.Close()
+			for _, r := range replicas {
+				r.Close()
+			}
+			return nil, err
+		}
+		replicas = append(replicas, replica)
+	}
+
+	// Create a routed, logged and traced driver and return
+	driver := newLoggingDriver(newReplicaDriver(primary, replicas...), queryLog)
+	driver = newTracingDriver(driver, nil)
+	client := ent.NewClient(ent.Driver(driver))
+	client.Use(auditHook(), tracingHook(nil))
+	return client, nil
+}
+
+// openDriver opens a postgres driver to connStr and waits for the database
+// to answer
+func openDriver(ctx context.Context, name, connStr string) (dialect.Driver, error) {
 	db, err := sql.Open(pgDriver, connStr)
 	if err != nil {
 		return nil, err
 	}
+	if err := waitForDB(ctx, db, name, connectBackoff); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return entsql.OpenDB(dialect.Postgres, db), nil
+}
 
-	// Create driver and return
-	driver := entsql.OpenDB(dialect.Postgres, db)
-	return ent.NewClient(ent.Driver(driver)), nil
+// seedReport counts what seeding created and what was already present
+type seedReport struct {
+	UsersCreated     int
+	UsersPresent     int
+	TitlesReconciled int
+	BlogsCreated     int
+	BlogsPresent     int
 }
 
-// seed seeds initial info into database
-func seed(ctx context.Context, cli *ent.Client) error {
-	for _, user := range seedInfo {
-		u, err := cli.User.Create().SetName(user.Name).SetEmail(user.Email).Save(ctx)
-		if err != nil {
-			return fmt.Errorf("while creating user %s: %w", user.Name, err)
-		}
+func (r seedReport) String() string {
+	return fmt.Sprintf("users: %d created, %d already present (%d titles reconciled); blogs: %d created, %d already present",
+		r.UsersCreated, r.UsersPresent, r.TitlesReconciled, r.BlogsCreated, r.BlogsPresent)
+}
 
-		if user.Title != "" {
-			u, err = u.Update().SetTitle(user.Title).Save(ctx)
+// seed seeds the fixture into the tenant of ctx. It can be run any number of
+// times: users are upserted by email and only missing blog posts are created.
+// Soft-deleted users are restored, soft-deleted blogs are left alone. Blogs
+// are written on behalf of no one, bypassing the privacy policies.
+func seed(ctx context.Context, cli *ent.Client, f *fixture) (seedReport, error) {
+	var report seedReport
+	ctx = schema.System(schema.IncludeDeleted(ctx))
+	ids := make(map[string]uuid.UUID, len(f.Users))
+	for _, info := range f.Users {
+		existing, err := cli.User.Query().Where(user.Email(info.Email)).Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			// Emails are unique across tenants, so the upsert would take
+			// over the user of another tenant.
+			taken, err := cli.User.Query().Where(user.Email(info.Email)).Exist(schema.AllTenants(ctx))
 			if err != nil {
-				return fmt.Errorf("while setting title for user %s: %w", user.Name, err)
+				return report, fmt.Errorf("while looking up user %s: %w", info.Name, err)
 			}
-		}
-
-		for i := 0; i < user.Blogs; i++ {
-			title := fmt.Sprintf("%s blog %d", user.Name, i+1)
-			content := fmt.Sprintf("%s blog %d body", user.Name, i+1)
-			blog, err := cli.Blog.Create().SetTitle(title).SetBody(content).Save(ctx)
-			if err != nil {
-				return fmt.Errorf("while creating blog %d for user %s: %w", i+1, user.Name, err)
+			if taken {
+				return report, fmt.Errorf("email of user %s is taken in another tenant", info.Name)
 			}
-			u, err = u.Update().AddBlogPosts(blog).Save(ctx)
-			if err != nil {
-				return fmt.Errorf("while adding blog %d for user %s: %w", i+1, user.Name, err)
+			report.UsersCreated++
+		case err != nil:
+			return report, fmt.Errorf("while looking up user %s: %w", info.Name, err)
+		default:
+			report.UsersPresent++
+			if existing.Title != info.Title {
+				report.TitlesReconciled++
 			}
+			// Unchanged users are left alone, keeping their version.
+			if existing.Name == info.Name && existing.Title == info.Title && existing.DeletedAt == nil {
+				ids[info.Key] = existing.ID
+				continue
+			}
+		}
+
+		create := cli.User.Create().SetName(info.Name).SetEmail(info.Email)
+		if info.Title != "" {
+			create.SetTitle(info.Title)
+		}
+		id, err := create.
+			OnConflictColumns(user.FieldEmail).
+			Update(func(u *ent.UserUpsert) {
+				u.UpdateName()
+				u.ClearDeletedAt()
+				u.UpdateUpdatedAt()
+				u.AddVersion(1)
+				if info.Title != "" {
+					u.UpdateTitle()
+				} else {
+					u.ClearTitle()
+				}
+			}).
+			ID(ctx)
+		if err != nil {
+			return report, fmt.Errorf("while upserting user %s: %w", info.Name, err)
+		}
+		ids[info.Key] = id
+	}
+
+	for _, info := range f.Blogs {
+		author := blog.Not(blog.HasAuthor())
+		if info.Author != "" {
+			author = blog.HasAuthorWith(user.ID(ids[info.Author]))
+		}
+		exists, err := cli.Blog.Query().Where(blog.Title(info.Title), author).Exist(ctx)
+		if err != nil {
+			return report, fmt.Errorf("while looking up blog %q: %w", info.Title, err)
 		}
+		if exists {
+			report.BlogsPresent++
+			continue
+		}
+		create := cli.Blog.Create().SetTitle(info.Title).SetBody(info.Body).SetStatus(blog.Status(info.Status))
+		if info.Author != "" {
+			create.SetAuthorID(ids[info.Author])
+		}
+		if err := create.Exec(ctx); err != nil {
+			return report, fmt.Errorf("while creating blog %q: %w", info.Title, err)
+		}
+		report.BlogsCreated++
 	}
-	return nil
+	return report, nil
 }
 
-func main() {
-	client, err := getClient("host=localhost port=5432 user=testuser dbname=test_migration password=testpswd")
+// seedTx runs seed inside a transaction so that either the whole fixture is
+// written or nothing is. On error, and always on a dry run, the transaction is
+// rolled back and the report tells what would have been written.
+func seedTx(ctx context.Context, cli *ent.Client, f *fixture, dryRun bool) (seedReport, error) {
+	tx, err := cli.Tx(ctx)
+	if err != nil {
+		return seedReport{}, fmt.Errorf("while starting transaction: %w", err)
+	}
+	report, err := seed(ctx, tx.Client(), f)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: while rolling back: %v", err, rerr)
+		}
+		return report, err
+	}
+	if dryRun {
+		return report, tx.Rollback()
+	}
+	if err := tx.Commit(); err != nil {
+		return report, fmt.Errorf("while committing: %w", err)
+	}
+	return report, nil
+}
+
+// connect returns a client to the primary and the replicas listed, comma
+// separated, in REPLICA_DSNS
+func connect(ctx context.Context) *ent.Client {
+	var replicas []string
+	if v := os.Getenv("REPLICA_DSNS"); v != "" {
+		replicas = strings.Split(v, ",")
+	}
+	client, err := getClient(ctx, primaryConnStr, replicas...)
 	if err != nil {
 		log.Fatalf("failed opening connection to postgres: %v", err)
 	}
+	return client
+}
+
+// sessionFlags are the flags of the commands working in a tenant, on
+// behalf of a user.
+type sessionFlags struct {
+	tenant string
+	as     string
+	admin  bool
+}
+
+func (s *sessionFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&s.tenant, "tenant", defaultTenant, "tenant to work in")
+	fs.StringVar(&s.as, "as", "", "email of the user to act as")
+	fs.BoolVar(&s.admin, "admin", false, "act as an admin")
+}
+
+// context returns a context scoped to the tenant of the session, whose
+// mutations run on behalf of the user it acts as, if any.
+func (s *sessionFlags) context(ctx context.Context, cli *ent.Client) (context.Context, error) {
+	t, err := findTenant(ctx, cli, s.tenant)
+	if err != nil {
+		return nil, err
+	}
+	ctx = schema.WithTenant(ctx, t.ID)
+	if s.as == "" && !s.admin {
+		return ctx, nil
+	}
+	v := schema.Viewer{Admin: s.admin}
+	if s.as != "" {
+		u, err := userByEmail(ctx, cli, s.as)
+		if err != nil {
+			return nil, fmt.Errorf("while looking up user %s: %w", s.as, err)
+		}
+		v.ID = u.ID
+	}
+	return schema.WithViewer(ctx, v), nil
+}
+
+// requireViewer returns the user ctx acts as, and exits if there is none,
+// for the commands acting on behalf of a user
+func requireViewer(ctx context.Context, command string) uuid.UUID {
+	v, _ := schema.ViewerFromContext(ctx)
+	if v.ID == uuid.Nil {
+		log.Fatalf("%s needs a user to act as, see -as", command)
+	}
+	return v.ID
+}
+
+// userByEmail returns the user with the given email, whatever its case.
+func userByEmail(ctx context.Context, cli *ent.Client, email string) (*ent.User, error) {
+	return cli.User.Query().Where(user.Email(schema.NormalizeEmail(email))).Only(ctx)
+}
+
+// uuidFlag defines a flag holding a uuid, checked when flags are parsed
+func uuidFlag(fs *flag.FlagSet, name, usage string) *uuid.UUID {
+	id := new(uuid.UUID)
+	fs.Func(name, usage, func(s string) (err error) {
+		*id, err = uuid.Parse(s)
+		return err
+	})
+	return id
+}
+
+// runSeed implements the seed command
+func runSeed(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("seed", flag.ExitOnError)
+	profile := fs.String("profile", defaultProfile, "fixture profile to seed (dev, demo, e2e)")
+	dryRun := fs.Bool("dry-run", false, "roll back the seed and only report what would have been written")
+	tenantName := fs.String("tenant", defaultTenant, "tenant to seed, created if missing")
+	fs.Parse(args)
+
+	f, err := loadFixture(fixturesDir, *profile)
+	if err != nil {
+		log.Fatalf("failed loading fixture: %v", err)
+	}
+
+	client := connect(ctx)
 	defer client.Close()
 
-	ctx := context.Background()
 	/*
 	if err := client.Schema.Create(ctx); err != nil {
 		log.Fatalf("failed creating ORM resources: %v", err)
 	}
 	*/
 
-	if err := seed(ctx, client); err != nil {
-		log.Fatalf("failed seeding data: %v", err)
+	// Seeding reads back what it has just written, so replicas must not serve it
+	ctx, err = tenantContext(withPrimary(ctx), client, *tenantName)
+	if err != nil {
+		log.Fatalf("failed setting up tenant: %v", err)
+	}
+	report, err := seedTx(ctx, client, f, *dryRun)
+	if err != nil {
+		log.Fatalf("failed seeding data, nothing was written (would have written %v): %v", report, err)
+	}
+	if *dryRun {
+		log.Printf("dry run, nothing was written (would have written %v)", report)
+		return
+	}
+	log.Printf("seeding done: %v", report)
+}
+
+// commands maps every command name to its implementation
+var commands = map[string]func(ctx context.Context, args []string){
+	"seed":             runSeed,
+	"generate":         runGenerate,
+	"reset":            runReset,
+	"purge":            runPurge,
+	"restore":          runRestore,
+	"audit":            runAudit,
+	"revisions":        runRevisions,
+	"diff":             runDiff,
+	"restore-revision": runRestoreRevision,
+	"check-emails":     runCheckEmails,
+	"follow":           runFollow,
+	"unfollow":         runUnfollow,
+	"followers":        runFollowers,
+	"blogs":            runBlogs,
+	"blog":             runBlog,
+	"edit":             runEdit,
+	"publish":          runPublish,
+	"archive":          runArchive,
+	"unarchive":        runUnarchive,
+	"tag":              runTag,
+	"untag":            runUntag,
+	"tags":             runTags,
+	"comment":          runComment,
+	"comments":         runComments,
+	"react":            runReact,
+}
+
+func main() {
+	// The command defaults to seed, so flags can be given right away
+	name, args := "seed", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+	run, ok := commands[name]
+	if !ok {
+		log.Fatalf("unknown command %q", name)
 	}
+	run(context.Background(), args)
 }
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/enttest"
+	"testMigrationEntgo/ent/schema"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// openTestClient returns a client to an in-memory database of its own,
+// with the schema created.
+func openTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	return newTestClient(t, openTestDriver(t))
+}
+
+// openTestDriver returns a driver to an in-memory database of its own.
+func openTestDriver(t *testing.T) dialect.Driver {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+	drv, err := entsql.Open(dialect.SQLite, dsn)
+	if err != nil {
+		t.Fatalf("opening database: %v", err)
+	}
+	return drv
+}
+
+// newTestClient returns a client using drv, with the schema created.
+func newTestClient(t *testing.T, drv dialect.Driver) *ent.Client {
+	t.Helper()
+	cli := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+	t.Cleanup(func() { cli.Close() })
+	return cli
+}
+
+// testTenant returns a context scoped to a new tenant with the given name.
+func testTenant(t *testing.T, cli *ent.Client, name string) context.Context {
+	t.Helper()
+	ctx, err := tenantContext(context.Background(), cli, name)
+	if err != nil {
+		t.Fatalf("tenantContext(%q): %v", name, err)
+	}
+	return ctx
+}
+
+// testUser creates a user with the given email in the tenant of ctx.
+func testUser(t *testing.T, ctx context.Context, cli *ent.Client, email string) *ent.User {
+	t.Helper()
+	u, err := cli.User.Create().SetName(email).SetEmail(email).Save(schema.System(ctx))
+	if err != nil {
+		t.Fatalf("creating user %s: %v", email, err)
+	}
+	return u
+}
+
+// testBlog creates a draft blog of author in the tenant of ctx.
+func testBlog(t *testing.T, ctx context.Context, cli *ent.Client, author *ent.User, title string) *ent.Blog {
+	t.Helper()
+	b, err := cli.Blog.Create().SetTitle(title).SetBody(title).SetAuthor(author).Save(schema.System(ctx))
+	if err != nil {
+		t.Fatalf("creating blog %q: %v", title, err)
+	}
+	return b
+}
+
+func TestSeedAgain(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := testTenant(t, cli, "t")
+	cli.Use(auditHook())
+	f := &fixture{
+		Users: []fixtureUser{{Key: "a", Name: "A", Email: "a@example.com"}, {Key: "b", Name: "B", Email: "b@example.com"}},
+		Blogs: []fixtureBlog{{Title: "t", Body: "b", Author: "a", Status: "draft"}},
+	}
+	if _, err := seedTx(ctx, cli, f, false); err != nil {
+		t.Fatalf("seeding: %v", err)
+	}
+	f.Users[1].Title = "Writer"
+	report, err := seedTx(ctx, cli, f, false)
+	if err != nil {
+		t.Fatalf("seeding again: %v", err)
+	}
+	if want := (seedReport{UsersPresent: 2, TitlesReconciled: 1, BlogsPresent: 1}); report != want {
+		t.Errorf("report: got %+v, want %+v", report, want)
+	}
+	// Only the changed user is updated.
+	versions := make(map[string]int)
+	for _, u := range cli.User.Query().AllX(ctx) {
+		versions[u.Email] = u.Version
+	}
+	if want := map[string]int{"a@example.com": 1, "b@example.com": 2}; !reflect.DeepEqual(versions, want) {
+		t.Errorf("versions: got %v, want %v", versions, want)
+	}
+}
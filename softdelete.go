@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/schema"
+	"testMigrationEntgo/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// restoreUser undoes the soft deletion of a user, provided it is still at
+// the given version. It reports whether the user was deleted at all, and
+// fails with a VersionConflictError if it changed since.
+func restoreUser(ctx context.Context, cli *ent.Client, id uuid.UUID, version int) (restored bool, err error) {
+	err = inTx(ctx, cli, func(cli *ent.Client) error {
+		n, err := cli.User.Update().
+			Where(user.ID(id), user.Version(version), user.DeletedAtNotNil()).
+			ClearDeletedAt().
+			Save(ctx)
+		if err != nil || n > 0 {
+			restored = n > 0
+			return err
+		}
+		// Nothing was restored, as the user was either not deleted or changed.
+		u, err := cli.User.Get(schema.IncludeDeleted(ctx), id)
+		if err != nil {
+			return err
+		}
+		if u.Version != version {
+			return &ent.VersionConflictError{Type: ent.TypeUser, ID: id}
+		}
+		return nil
+	})
+	return restored, err
+}
+
+// restoreBlog undoes the soft deletion of a blog, provided it is still at
+// the given version. It reports whether the blog was deleted at all, and
+// fails with a VersionConflictError if it changed since.
+func restoreBlog(ctx context.Context, cli *ent.Client, id uuid.UUID, version int) (restored bool, err error) {
+	err = inTx(ctx, cli, func(cli *ent.Client) error {
+		n, err := cli.Blog.Update().
+			Where(blog.ID(id), blog.Version(version), blog.DeletedAtNotNil()).
+			ClearDeletedAt().
+			Save(ctx)
+		if err != nil || n > 0 {
+			restored = n > 0
+			return err
+		}
+		// Nothing was restored, as the blog was either not deleted or changed.
+		b, err := cli.Blog.Get(schema.IncludeDeleted(ctx), id)
+		if err != nil {
+			return err
+		}
+		if b.Version != version {
+			return &ent.VersionConflictError{Type: ent.TypeBlog, ID: id}
+		}
+		return nil
+	})
+	return restored, err
+}
+
+// runRestore implements the restore command
+func runRestore(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	s.register(fs)
+	kind := fs.String("kind", "blog", "kind of the entity to restore (user, blog)")
+	id := uuidFlag(fs, "id", "id of the entity to restore")
+	version := fs.Int("version", 0, "version of the entity the restore was decided at")
+	fs.Parse(args)
+
+	restore := map[string]func(context.Context, *ent.Client, uuid.UUID, int) (bool, error){
+		"user": restoreUser,
+		"blog": restoreBlog,
+	}[*kind]
+	if restore == nil {
+		log.Fatalf("unknown kind %q", *kind)
+	}
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(withPrimary(ctx), client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	restored, err := restore(ctx, client, *id, *version)
+	if err != nil {
+		log.Fatalf("failed restoring %s %s: %v", *kind, *id, err)
+	}
+	if !restored {
+		log.Printf("%s %s was not deleted", *kind, *id)
+		return
+	}
+	log.Printf("restored %s %s", *kind, *id)
+}
+
+// purgeReport counts the rows removed for good by purge
+type purgeReport struct {
+	Users int
+	Blogs int
+}
+
+// purge removes for good the users and blogs soft-deleted before cutoff,
+// whoever authored them. With dryRun, it only counts them.
+func purge(ctx context.Context, cli *ent.Client, cutoff time.Time, dryRun bool) (purgeReport, error) {
+	var report purgeReport
+	ctx = schema.System(schema.HardDelete(ctx))
+	var err error
+	if dryRun {
+		if report.Blogs, err = cli.Blog.Query().Where(blog.DeletedAtLT(cutoff)).Count(ctx); err != nil {
+			return report, err
+		}
+		report.Users, err = cli.User.Query().Where(user.DeletedAtLT(cutoff)).Count(ctx)
+		return report, err
+	}
+	err = inTx(ctx, cli, func(cli *ent.Client) (err error) {
+		if report.Blogs, err = cli.Blog.Delete().Where(blog.DeletedAtLT(cutoff)).Exec(ctx); err != nil {
+			return err
+		}
+		report.Users, err = cli.User.Delete().Where(user.DeletedAtLT(cutoff)).Exec(ctx)
+		return err
+	})
+	return report, err
+}
+
+// runPurge implements the purge command
+func runPurge(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("purge", flag.ExitOnError)
+	olderThan := fs.Duration("older-than", 30*24*time.Hour, "only purge rows soft-deleted at least this long ago")
+	dryRun := fs.Bool("dry-run", false, "only report how many rows would be purged")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	report, err := purge(schema.AllTenants(withPrimary(ctx)), client, time.Now().Add(-*olderThan), *dryRun)
+	if err != nil {
+		log.Fatalf("failed purging deleted rows: %v", err)
+	}
+	if *dryRun {
+		log.Printf("dry run, would purge %d users and %d blogs", report.Users, report.Blogs)
+		return
+	}
+	log.Printf("purged %d users and %d blogs", report.Users, report.Blogs)
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/blogrevision"
+	"testMigrationEntgo/ent/schema"
+	"testMigrationEntgo/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// maxBatchSize keeps bulk inserts under the 65535 bind parameters postgres
+// accepts in a single statement, every row binding every column of its table.
+var maxBatchSize = math.MaxUint16 / max(
+	len(user.Columns),
+	len(blog.Columns)+len(blog.ForeignKeys),
+	len(blogrevision.Columns),
+)
+
+// Word lists the synthetic data is built from.
+var (
+	synthFirstNames = []string{
+		"Ada", "Alan", "Alice", "Barbara", "Bjarne", "Carla", "Charles", "Dennis", "Donald", "Edsger",
+		"Elena", "Frances", "Grace", "Guido", "Hedy", "Ivan", "Jean", "John", "Ken", "Linus",
+		"Lucia", "Margaret", "Maria", "Niklaus", "Pablo", "Radia", "Rob", "Sofia", "Tim", "Yukihiro",
+	}
+	synthLastNames = []string{
+		"Allen", "Backus", "Berners-Lee", "Cerf", "Dijkstra", "Engelbart", "Garcia", "Goldberg", "Hamilton", "Hopper",
+		"Kahn", "Kernighan", "Knuth", "Lamarr", "Liskov", "Lovelace", "Martinez", "McCarthy", "Perlman", "Pike",
+		"Ritchie", "Rossum", "Stroustrup", "Sutherland", "Thompson", "Torvalds", "Turing", "Wirth", "Wozniak", "Lopez",
+	}
+	synthDomains = []string{"gmail.com", "hotmail.com", "yahoo.com", "example.com", "example.org", "mail.test"}
+	synthTitles  = []string{"Engineer", "Writer", "Researcher", "Student", "Architect", "Manager", "Designer", ""}
+	synthWords   = []string{
+		"query", "index", "plan", "schema", "migration", "table", "column", "database", "replica", "cache",
+		"latency", "throughput", "transaction", "lock", "vacuum", "partition", "join", "scan", "tuple", "page",
+		"backup", "restore", "cluster", "shard", "storage", "memory", "buffer", "write", "read", "commit",
+		"graph", "edge", "node", "hook", "driver", "client", "server", "request", "response", "benchmark",
+	}
+)
+
+// synthOptions configures the synthetic data generator.
+type synthOptions struct {
+	Users        int
+	BlogsPerUser float64
+	BatchSize    int
+	Seed         int64
+	// Span is how far back in time blog posts are spread.
+	Span time.Duration
+	// Now is the most recent creation time of a blog post.
+	Now time.Time
+}
+
+// synthesizer produces deterministic fake data for a given seed.
+type synthesizer struct {
+	opts synthOptions
+	rand *rand.Rand
+}
+
+func newSynthesizer(opts synthOptions) *synthesizer {
+	return &synthesizer{opts: opts, rand: rand.New(rand.NewSource(opts.Seed))}
+}
+
+func (s *synthesizer) pick(words []string) string {
+	return words[s.rand.Intn(len(words))]
+}
+
+// user returns the name, unique email and optional title of the n-th user.
+func (s *synthesizer) user(n int) (name, email, title string) {
+	first, last := s.pick(synthFirstNames), s.pick(synthLastNames)
+	name = first + " " + last
+	local := strings.ToLower(strings.ReplaceAll(first+"."+last, "-", ""))
+	email = fmt.Sprintf("%s.%d.%d@%s", local, s.opts.Seed, n, s.pick(synthDomains))
+	return name, email, s.pick(synthTitles)
+}
+
+// blogCount returns how many posts a user has, following a Poisson
+// distribution around BlogsPerUser.
+func (s *synthesizer) blogCount() int {
+	l, k, p := math.Exp(-s.opts.BlogsPerUser), 0, 1.0
+	for {
+		p *= s.rand.Float64()
+		if p <= l {
+			return k
+		}
+		k++
+	}
+}
+
+func (s *synthesizer) sentence(min, max int) string {
+	words := make([]string, min+s.rand.Intn(max-min+1))
+	for i := range words {
+		words[i] = s.pick(synthWords)
+	}
+	words[0] = strings.ToUpper(words[0][:1]) + words[0][1:]
+	return strings.Join(words, " ")
+}
+
+func (s *synthesizer) body() string {
+	paragraphs := make([]string, 1+s.rand.Intn(5))
+	for i := range paragraphs {
+		sentences := make([]string, 2+s.rand.Intn(6))
+		for j := range sentences {
+			sentences[j] = s.sentence(5, 15) + "."
+		}
+		paragraphs[i] = strings.Join(sentences, " ")
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// createdAt returns a creation time skewed towards the recent past, as
+// activity tends to grow over time.
+func (s *synthesizer) createdAt() time.Time {
+	age := s.rand.ExpFloat64() / 3
+	if age > 1 {
+		age = s.rand.Float64()
+	}
+	return s.opts.Now.Add(-time.Duration(age * float64(s.opts.Span)))
+}
+
+// status returns a blog status, most posts being published.
+func (s *synthesizer) status() blog.Status {
+	switch r := s.rand.Float64(); {
+	case r < 0.8:
+		return blog.StatusPublished
+	case r < 0.95:
+		return blog.StatusDraft
+	default:
+		return blog.StatusArchived
+	}
+}
+
+// synthBlog is a blog post waiting to be inserted.
+type synthBlog struct {
+	title, body string
+	slug        string
+	status      blog.Status
+	createdAt   time.Time
+	author      uuid.UUID
+}
+
+// generate inserts the synthetic users, in batches, along with their posts,
+// into the tenant of ctx. Posts are written on behalf of no one, bypassing
+// the privacy policies.
+func generate(ctx context.Context, cli *ent.Client, opts synthOptions) error {
+	ctx = schema.System(ctx)
+	s := newSynthesizer(opts)
+	start := time.Now()
+	users, blogs := 0, 0
+	for users < opts.Users {
+		size := min(opts.BatchSize, opts.Users-users)
+		var created []*ent.User
+		err := inTx(ctx, cli, func(cli *ent.Client) (err error) {
+			created, err = cli.User.MapCreateBulk(make([]struct{}, size), func(c *ent.UserCreate, i int) {
+				name, email, title := s.user(users + i)
+				c.SetName(name).SetEmail(email)
+				if title != "" {
+					c.SetTitle(title)
+				}
+			}).Save(ctx)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("while creating users %d to %d: %w", users+1, users+size, err)
+		}
+		users += len(created)
+
+		var pending []synthBlog
+		for _, u := range created {
+			// Slugs are set here as the hook generating them can't see
+			// the other blogs of a batch.
+			used := make(map[string]bool)
+			for i, n := 0, s.blogCount(); i < n; i++ {
+				title := s.sentence(3, 8)
+				base := schema.Slugify(title)
+				slug := base
+				for k := 2; used[slug]; k++ {
+					slug = fmt.Sprintf("%s-%d", base, k)
+				}
+				used[slug] = true
+				pending = append(pending, synthBlog{
+					title:     title,
+					slug:      slug,
+					body:      s.body(),
+					status:    s.status(),
+					createdAt: s.createdAt(),
+					author:    u.ID,
+				})
+			}
+		}
+		for len(pending) > 0 {
+			batch := pending[:min(opts.BatchSize, len(pending))]
+			pending = pending[len(batch):]
+			// The first revisions of the blogs are stored in bulk too,
+			// rather than by the hook, one at a time.
+			err := inTx(ctx, cli, func(cli *ent.Client) error {
+				created, err := cli.Blog.MapCreateBulk(batch, func(c *ent.BlogCreate, i int) {
+					b := batch[i]
+					c.SetTitle(b.title).
+						SetSlug(b.slug).
+						SetBody(b.body).
+						SetStatus(b.status).
+						SetCreatedAt(b.createdAt).
+						SetAuthorID(b.author)
+					if b.status != blog.StatusDraft {
+						c.SetPublishedAt(b.createdAt)
+					}
+					if b.status == blog.StatusArchived {
+						c.SetArchivedAt(b.createdAt)
+					}
+				}).Save(schema.NoRevisions(ctx))
+				if err != nil {
+					return err
+				}
+				return cli.BlogRevision.MapCreateBulk(created, func(c *ent.BlogRevisionCreate, i int) {
+					b := created[i]
+					c.SetTenantID(b.TenantID).
+						SetBlogID(b.ID).
+						SetNumber(1).
+						SetTitle(b.Title).
+						SetBody(b.Body).
+						SetCreatedAt(b.CreatedAt)
+				}).Exec(ctx)
+			})
+			if err != nil {
+				return fmt.Errorf("while creating blogs: %w", err)
+			}
+			blogs += len(batch)
+		}
+
+		elapsed := time.Since(start)
+		log.Printf("generated %d/%d users (%.1f%%) and %d blogs in %s, %.0f rows/s",
+			users, opts.Users, 100*float64(users)/float64(opts.Users), blogs,
+			elapsed.Round(time.Second), float64(users+blogs)/elapsed.Seconds())
+	}
+	return nil
+}
+
+// runGenerate implements the generate command
+func runGenerate(ctx context.Context, args []string) {
+	var opts synthOptions
+	fs := flag.NewFlagSet("generate", flag.ExitOnError)
+	fs.IntVar(&opts.Users, "users", 100000, "number of users to generate")
+	fs.Float64Var(&opts.BlogsPerUser, "blogs-per-user", 5, "average number of blog posts per user")
+	fs.IntVar(&opts.BatchSize, "batch", 1000, fmt.Sprintf("rows per bulk insert, at most %d", maxBatchSize))
+	fs.Int64Var(&opts.Seed, "seed", 1, "random seed, the same seed generates the same data")
+	fs.DurationVar(&opts.Span, "span", 2*365*24*time.Hour, "how far back blog posts are spread")
+	until := fs.String("until", time.Now().UTC().Format(time.DateOnly), "date of the most recent blog posts (YYYY-MM-DD)")
+	tenantName := fs.String("tenant", defaultTenant, "tenant to generate data in, created if missing")
+	fs.Parse(args)
+
+	now, err := time.Parse(time.DateOnly, *until)
+	if err != nil {
+		log.Fatalf("invalid until date: %v", err)
+	}
+	opts.Now = now
+
+	if opts.Users <= 0 || opts.BlogsPerUser < 0 || opts.BatchSize <= 0 || opts.BatchSize > maxBatchSize || opts.Span <= 0 {
+		log.Fatalf("invalid options: users and span must be positive, blogs-per-user not negative and batch between 1 and %d", maxBatchSize)
+	}
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err = tenantContext(withPrimary(ctx), client, *tenantName)
+	if err != nil {
+		log.Fatalf("failed setting up tenant: %v", err)
+	}
+	if err := generate(ctx, client, opts); err != nil {
+		log.Fatalf("failed generating data: %v", err)
+	}
+}
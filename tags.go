@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/predicate"
+	"testMigrationEntgo/ent/schema"
+	"testMigrationEntgo/ent/tag"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// normalizeTags normalizes names and drops empty and duplicate ones.
+func normalizeTags(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	var tags []string
+	for _, name := range names {
+		name = schema.NormalizeTag(name)
+		if name != "" && !seen[name] {
+			seen[name] = true
+			tags = append(tags, name)
+		}
+	}
+	return tags
+}
+
+// tagBlog adds tags to a blog, creating the ones that don't exist yet. Tags
+// the blog already has are left as is. The blog must still be at the given
+// version, as for editBlog.
+func tagBlog(ctx context.Context, cli *ent.Client, blogID uuid.UUID, version int, names ...string) error {
+	names = normalizeTags(names)
+	if len(names) == 0 {
+		return nil
+	}
+	return inTx(ctx, cli, func(cli *ent.Client) error {
+		// Tags are upserted one at a time, as a bulk upsert doing nothing
+		// on conflict returns fewer ids than it has rows, and they can't
+		// be matched back to their tags.
+		for _, name := range names {
+			err := cli.Tag.Create().
+				SetName(name).
+				OnConflictColumns(tag.FieldName).
+				DoNothing().
+				Exec(ctx)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("while creating tag %s: %w", name, err)
+			}
+		}
+		ids, err := cli.Tag.Query().Where(tag.NameIn(names...)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		has, err := cli.Blog.Query().Where(blog.ID(blogID)).QueryTags().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		existing := make(map[int]bool, len(has))
+		for _, id := range has {
+			existing[id] = true
+		}
+		var add []int
+		for _, id := range ids {
+			if !existing[id] {
+				add = append(add, id)
+			}
+		}
+		if len(add) == 0 {
+			return nil
+		}
+		return cli.Blog.UpdateOneID(blogID).
+			Where(blog.Version(version)).
+			AddTagIDs(add...).
+			Exec(ctx)
+	})
+}
+
+// untagBlog removes tags from a blog. The tags themselves are kept. The
+// blog must still be at the given version, as for editBlog.
+func untagBlog(ctx context.Context, cli *ent.Client, blogID uuid.UUID, version int, names ...string) error {
+	return inTx(ctx, cli, func(cli *ent.Client) error {
+		ids, err := cli.Tag.Query().Where(tag.NameIn(normalizeTags(names)...)).IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		return cli.Blog.UpdateOneID(blogID).
+			Where(blog.Version(version)).
+			RemoveTagIDs(ids...).
+			Exec(ctx)
+	})
+}
+
+// hasAnyTag matches the blogs having at least one of the given tags.
+func hasAnyTag(names ...string) predicate.Blog {
+	return blog.HasTagsWith(tag.NameIn(normalizeTags(names)...))
+}
+
+// hasAllTags matches the blogs having every one of the given tags.
+func hasAllTags(names ...string) predicate.Blog {
+	names = normalizeTags(names)
+	ps := make([]predicate.Blog, len(names))
+	for i, name := range names {
+		ps[i] = blog.HasTagsWith(tag.Name(name))
+	}
+	return blog.And(ps...)
+}
+
+// tagCount is the number of blogs having a tag.
+type tagCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// tagCounts returns every tag along with the number of blogs in any of the
+// given statuses having it, most used first, then by name. Only published
+// blogs are counted when no status is given, and deleted blogs never are.
+func tagCounts(ctx context.Context, cli *ent.Client, statuses ...blog.Status) ([]tagCount, error) {
+	if len(statuses) == 0 {
+		statuses = []blog.Status{blog.StatusPublished}
+	}
+	in := make([]any, len(statuses))
+	for i, s := range statuses {
+		in[i] = s
+	}
+	// Joined blogs are not filtered by tenant as queried ones are.
+	tenant, scoped, err := schema.TenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var counts []tagCount
+	err = cli.Tag.Query().
+		GroupBy(tag.FieldName).
+		Aggregate(func(s *entsql.Selector) string {
+			// Blogs are joined in a single query, the ones that don't
+			// count are filtered out in the join so that unused tags
+			// are still returned.
+			bt, b := entsql.Table(blog.TagsTable), entsql.Table(blog.Table)
+			s.LeftJoin(bt).
+				On(s.C(tag.FieldID), bt.C(blog.TagsPrimaryKey[1])).
+				LeftJoin(b)
+			on := []*entsql.Predicate{
+				entsql.ColumnsEQ(bt.C(blog.TagsPrimaryKey[0]), b.C(blog.FieldID)),
+				entsql.IsNull(b.C(blog.FieldDeletedAt)),
+				entsql.In(b.C(blog.FieldStatus), in...),
+			}
+			if scoped {
+				on = append(on, entsql.EQ(b.C(blog.FieldTenantID), tenant))
+			}
+			s.OnP(entsql.And(on...))
+			return entsql.As(entsql.Count(b.C(blog.FieldID)), "count")
+		}).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(counts, func(a, b tagCount) int {
+		if a.Count != b.Count {
+			return b.Count - a.Count
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return counts, nil
+}
+
+// runTag implements the tag command
+func runTag(ctx context.Context, args []string) {
+	runTagChange(ctx, "tag", args, tagBlog)
+}
+
+// runUntag implements the untag command
+func runUntag(ctx context.Context, args []string) {
+	runTagChange(ctx, "untag", args, untagBlog)
+}
+
+func runTagChange(ctx context.Context, command string, args []string, change func(context.Context, *ent.Client, uuid.UUID, int, ...string) error) {
+	var s sessionFlags
+	fs := flag.NewFlagSet(command, flag.ExitOnError)
+	s.register(fs)
+	id := uuidFlag(fs, "id", "id of the blog to "+command)
+	version := fs.Int("version", 0, "version of the blog the change was decided at")
+	tags := fs.String("tags", "", "comma separated tags")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(withPrimary(ctx), client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	if err := change(ctx, client, *id, *version, strings.Split(*tags, ",")...); err != nil {
+		log.Fatalf("failed to %s blog %s: %v", command, *id, err)
+	}
+	log.Printf("%sged blog %s", command, *id)
+}
+
+// runTags implements the tags command, listing the tags by use
+func runTags(ctx context.Context, args []string) {
+	var s sessionFlags
+	fs := flag.NewFlagSet("tags", flag.ExitOnError)
+	s.register(fs)
+	statuses := fs.String("status", string(blog.StatusPublished), "comma separated statuses of the blogs counted")
+	fs.Parse(args)
+
+	client := connect(ctx)
+	defer client.Close()
+
+	ctx, err := s.context(ctx, client)
+	if err != nil {
+		log.Fatalf("failed setting up session: %v", err)
+	}
+	counts, err := tagCounts(ctx, client, parseStatuses(*statuses)...)
+	if err != nil {
+		log.Fatalf("failed counting tags: %v", err)
+	}
+	for _, c := range counts {
+		fmt.Printf("%s\t%d\n", c.Name, c.Count)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/auditlog"
+	"testMigrationEntgo/ent/schema"
+	"testMigrationEntgo/ent/tag"
+)
+
+func TestTagBlog(t *testing.T) {
+	cli := openTestClient(t)
+	ctx := testTenant(t, cli, "t")
+	author := testUser(t, ctx, cli, "a@example.com")
+	b := testBlog(t, ctx, cli, author, "post")
+	cli.Tag.Create().SetName("go").ExecX(schema.System(ctx))
+	cli.Use(auditHook())
+	ctx = schema.WithViewer(ctx, schema.Viewer{ID: author.ID})
+
+	if err := tagBlog(ctx, cli, b.ID, b.Version, "Go", "db"); err != nil {
+		t.Fatalf("tagBlog: %v", err)
+	}
+	names, err := cli.Blog.GetX(ctx, b.ID).QueryTags().Select(tag.FieldName).Strings(ctx)
+	if err != nil {
+		t.Fatalf("listing tags: %v", err)
+	}
+	slices.Sort(names)
+	if want := []string{"db", "go"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("tags: got %v, want %v", names, want)
+	}
+	// Only the new tag is logged as created, with its own id.
+	created, err := cli.AuditLog.Query().
+		Where(auditlog.EntityType(ent.TypeTag), auditlog.Op(ent.OpCreate.String())).
+		All(ctx)
+	if err != nil {
+		t.Fatalf("listing audit logs: %v", err)
+	}
+	db := cli.Tag.Query().Where(tag.Name("db")).OnlyX(ctx)
+	if len(created) != 1 || created[0].EntityID != fmt.Sprint(db.ID) {
+		t.Errorf("tag creations: got %+v, want one of tag %d", created, db.ID)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/schema"
+	"testMigrationEntgo/ent/tenant"
+)
+
+// defaultTenant is the tenant commands work on unless told otherwise, the
+// add_tenants migration moves pre-existing users and blogs to it.
+const defaultTenant = "default"
+
+// ensureTenant returns the tenant with the given name, creating it if it
+// doesn't exist yet.
+func ensureTenant(ctx context.Context, cli *ent.Client, name string) (*ent.Tenant, error) {
+	// Tenants belong to none, nor do the audit logs of their creation.
+	ctx = schema.AllTenants(ctx)
+	// Doing nothing on conflict returns no rows, so the tenant is looked up
+	// afterwards.
+	err := inTx(ctx, cli, func(cli *ent.Client) error {
+		err := cli.Tenant.Create().
+			SetName(name).
+			OnConflictColumns(tenant.FieldName).
+			DoNothing().
+			Exec(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("while creating tenant %s: %w", name, err)
+	}
+	return cli.Tenant.Query().Where(tenant.Name(name)).Only(ctx)
+}
+
+// findTenant returns the tenant with the given name, which must exist.
+func findTenant(ctx context.Context, cli *ent.Client, name string) (*ent.Tenant, error) {
+	t, err := cli.Tenant.Query().Where(tenant.Name(name)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("while looking up tenant %s: %w", name, err)
+	}
+	return t, nil
+}
+
+// tenantContext returns a context scoped to the tenant with the given name,
+// creating the tenant if it doesn't exist yet.
+func tenantContext(ctx context.Context, cli *ent.Client, name string) (context.Context, error) {
+	t, err := ensureTenant(ctx, cli, name)
+	if err != nil {
+		return nil, err
+	}
+	return schema.WithTenant(ctx, t.ID), nil
+}
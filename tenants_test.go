@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"testMigrationEntgo/ent"
+	"testMigrationEntgo/ent/blog"
+	"testMigrationEntgo/ent/schema"
+)
+
+func TestEnsureTenant(t *testing.T) {
+	cli := openTestClient(t)
+	cli.Use(auditHook())
+	ctx := context.Background()
+
+	first, err := ensureTenant(ctx, cli, "t")
+	if err != nil {
+		t.Fatalf("ensureTenant: %v", err)
+	}
+	again, err := ensureTenant(ctx, cli, "t")
+	if err != nil {
+		t.Fatalf("ensureTenant of an existing tenant: %v", err)
+	}
+	if again.ID != first.ID {
+		t.Errorf("ensureTenant of an existing tenant: got %s, want %s", again.ID, first.ID)
+	}
+}
+
+func TestCrossTenantReads(t *testing.T) {
+	cli := openTestClient(t)
+	ctxA, ctxB := testTenant(t, cli, "a"), testTenant(t, cli, "b")
+	author := testUser(t, ctxA, cli, "a@example.com")
+	b := testBlog(t, ctxA, cli, author, "post")
+	if err := b.Update().SetStatus(blog.StatusPublished).Exec(schema.System(ctxA)); err != nil {
+		t.Fatalf("publishing: %v", err)
+	}
+	if err := tagBlog(schema.System(ctxA), cli, b.ID, b.Version+1, "go"); err != nil {
+		t.Fatalf("tagBlog: %v", err)
+	}
+
+	if _, err := cli.Blog.Get(ctxB, b.ID); !ent.IsNotFound(err) {
+		t.Errorf("blog of another tenant: got error %v, want not found", err)
+	}
+	if n := queryBlogs(cli).CountX(ctxB); n != 0 {
+		t.Errorf("blogs of another tenant: got %d, want none", n)
+	}
+	if n := cli.BlogRevision.Query().CountX(ctxB); n != 0 {
+		t.Errorf("revisions of another tenant: got %d, want none", n)
+	}
+	if _, err := userByEmail(ctxB, cli, author.Email); !ent.IsNotFound(err) {
+		t.Errorf("user of another tenant: got error %v, want not found", err)
+	}
+	// Tags are shared, their counts are not.
+	if counts := mustTagCounts(t, ctxB, cli); len(counts) != 1 || counts[0].Count != 0 {
+		t.Errorf("tag counts of another tenant: got %+v, want go unused", counts)
+	}
+	if counts := mustTagCounts(t, ctxA, cli); len(counts) != 1 || counts[0].Count != 1 {
+		t.Errorf("tag counts: got %+v, want go used once", counts)
+	}
+}
+
+func mustTagCounts(t *testing.T, ctx context.Context, cli *ent.Client) []tagCount {
+	t.Helper()
+	counts, err := tagCounts(ctx, cli)
+	if err != nil {
+		t.Fatalf("tagCounts: %v", err)
+	}
+	return counts
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"testMigrationEntgo/ent"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "testMigrationEntgo"
+
+// Attribute keys that are not covered by the semantic conventions.
+var (
+	dbRowsAffectedKey = attribute.Key("db.rows_affected")
+	dbArgsCountKey    = attribute.Key("db.args.count")
+	entTypeKey        = attribute.Key("ent.type")
+	entOpKey          = attribute.Key("ent.op")
+)
+
+// tracingDriver is a dialect.Driver that creates a span for every statement
+// and transaction executed through the underlying driver. Statement arguments
+// are never recorded, only how many of them were bound.
+type tracingDriver struct {
+	dialect.Driver
+	tracer trace.Tracer
+}
+
+// newTracingDriver wraps drv so every Exec, Query and Tx gets a span. If tp is
+// nil, the global tracer provider is used.
+func newTracingDriver(drv dialect.Driver, tp trace.TracerProvider) dialect.Driver {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return &tracingDriver{Driver: drv, tracer: tp.Tracer(tracerName)}
+}
+
+// Exec traces and calls the underlying driver Exec method.
+func (d *tracingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return traceExec(ctx, d.tracer, "Exec", query, args, v, d.Driver.Exec)
+}
+
+// Query traces and calls the underlying driver Query method.
+func (d *tracingDriver) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, d.tracer, "Query", query, args, v, d.Driver.Query)
+}
+
+// ExecContext traces and calls the underlying driver ExecContext method if it is supported.
+func (d *tracingDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	drv, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	var res sql.Result
+	err := traceExec(ctx, d.tracer, "ExecContext", query, args, &res, func(ctx context.Context, query string, _, _ any) (err error) {
+		res, err = drv.ExecContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+// QueryContext traces and calls the underlying driver QueryContext method if it is supported.
+func (d *tracingDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	drv, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	var rows *sql.Rows
+	err := traceQuery(ctx, d.tracer, "QueryContext", query, args, nil, func(ctx context.Context, query string, _, _ any) (err error) {
+		rows, err = drv.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// Tx starts a transaction span that ends on Commit or Rollback.
+func (d *tracingDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.beginTx(ctx, "Tx", func(ctx context.Context) (dialect.Tx, error) {
+		return d.Driver.Tx(ctx)
+	})
+}
+
+// BeginTx starts a transaction span that ends on Commit or Rollback. It calls
+// the underlying driver BeginTx command if it is supported.
+func (d *tracingDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	return d.beginTx(ctx, "BeginTx", func(ctx context.Context) (dialect.Tx, error) {
+		return drv.BeginTx(ctx, opts)
+	})
+}
+
+func (d *tracingDriver) beginTx(ctx context.Context, name string, begin func(context.Context) (dialect.Tx, error)) (dialect.Tx, error) {
+	ctx, span := d.tracer.Start(ctx, "db."+name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(semconv.DBSystemPostgreSQL))
+	tx, err := begin(ctx)
+	if err != nil {
+		endSpan(span, err)
+		return nil, err
+	}
+	return &tracingTx{Tx: tx, tracer: d.tracer, ctx: ctx, span: span}, nil
+}
+
+// tracingTx is a dialect.Tx whose statements are children of the span started
+// along with the transaction.
+type tracingTx struct {
+	dialect.Tx
+	tracer trace.Tracer
+	ctx    context.Context
+	span   trace.Span
+}
+
+// Exec traces and calls the underlying transaction Exec method.
+func (t *tracingTx) Exec(ctx context.Context, query string, args, v any) error {
+	return traceExec(t.parent(ctx), t.tracer, "Tx.Exec", query, args, v, t.Tx.Exec)
+}
+
+// Query traces and calls the underlying transaction Query method.
+func (t *tracingTx) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(t.parent(ctx), t.tracer, "Tx.Query", query, args, v, t.Tx.Query)
+}
+
+// Commit commits the transaction and ends its span.
+func (t *tracingTx) Commit() error {
+	err := t.Tx.Commit()
+	t.span.AddEvent("commit")
+	endSpan(t.span, err)
+	return err
+}
+
+// Rollback rolls back the transaction and ends its span.
+func (t *tracingTx) Rollback() error {
+	err := t.Tx.Rollback()
+	t.span.AddEvent("rollback")
+	endSpan(t.span, err)
+	return err
+}
+
+// parent returns ctx if it already carries a span, or the transaction context
+// otherwise, so statements are never orphaned from their transaction.
+func (t *tracingTx) parent(ctx context.Context) context.Context {
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	return t.ctx
+}
+
+type execFunc func(ctx context.Context, query string, args, v any) error
+
+func traceExec(ctx context.Context, tracer trace.Tracer, name, query string, args, v any, do execFunc) error {
+	ctx, span := startStatementSpan(ctx, tracer, name, query, args)
+	err := do(ctx, query, args, v)
+	if res, ok := v.(*sql.Result); ok && err == nil && *res != nil {
+		if n, rerr := (*res).RowsAffected(); rerr == nil {
+			span.SetAttributes(dbRowsAffectedKey.Int64(n))
+		}
+	}
+	endSpan(span, err)
+	return err
+}
+
+func traceQuery(ctx context.Context, tracer trace.Tracer, name, query string, args, v any, do execFunc) error {
+	ctx, span := startStatementSpan(ctx, tracer, name, query, args)
+	err := do(ctx, query, args, v)
+	endSpan(span, err)
+	return err
+}
+
+// startStatementSpan starts a client span for a single statement. Only the
+// parameterized statement is recorded; bound values are redacted.
+func startStatementSpan(ctx context.Context, tracer trace.Tracer, name, query string, args any) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "db."+name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBStatement(query),
+			dbArgsCountKey.Int(argsCount(args)),
+		),
+	)
+}
+
+func argsCount(args any) int {
+	if argv, ok := args.([]any); ok {
+		return len(argv)
+	}
+	return 0
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// tracingHook returns a mutation hook that starts a span for every ent
+// mutation, so the statements it triggers are grouped under it.
+func tracingHook(tp trace.TracerProvider) ent.Hook {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	tracer := tp.Tracer(tracerName)
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			ctx, span := tracer.Start(ctx, fmt.Sprintf("ent.%s.%s", m.Type(), m.Op()),
+				trace.WithAttributes(
+					entTypeKey.String(m.Type()),
+					entOpKey.String(m.Op().String()),
+				),
+			)
+			v, err := next.Mutate(ctx, m)
+			endSpan(span, err)
+			return v, err
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// stubDriver is a dialect.Driver whose statements affect rowsAffected rows,
+// or fail with err.
+type stubDriver struct {
+	rowsAffected int64
+	err          error
+}
+
+func (d *stubDriver) Exec(_ context.Context, _ string, _, v any) error {
+	if d.err != nil {
+		return d.err
+	}
+	if res, ok := v.(*sql.Result); ok {
+		*res = driverResult(d.rowsAffected)
+	}
+	return nil
+}
+
+func (d *stubDriver) Query(context.Context, string, any, any) error { return d.err }
+func (d *stubDriver) Tx(context.Context) (dialect.Tx, error)        { return stubTx{d}, nil }
+func (d *stubDriver) Close() error                                  { return nil }
+func (d *stubDriver) Dialect() string                               { return dialect.Postgres }
+
+type stubTx struct{ *stubDriver }
+
+func (stubTx) Commit() error   { return nil }
+func (stubTx) Rollback() error { return nil }
+
+type driverResult int64
+
+func (r driverResult) LastInsertId() (int64, error) { return 0, nil }
+func (r driverResult) RowsAffected() (int64, error) { return int64(r), nil }
+
+func newRecordedDriver(drv dialect.Driver) (dialect.Driver, *tracetest.SpanRecorder) {
+	rec := tracetest.NewSpanRecorder()
+	return newTracingDriver(drv, sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))), rec
+}
+
+func TestTracingDriverExec(t *testing.T) {
+	drv, rec := newRecordedDriver(&stubDriver{rowsAffected: 3})
+	const query = `UPDATE "users" SET "name" = $1 WHERE "email" = $2`
+	var res sql.Result
+	if err := drv.Exec(context.Background(), query, []any{"Jane", "jane@example.com"}, &res); err != nil {
+		t.Fatal(err)
+	}
+	spans := rec.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "db.Exec" {
+		t.Errorf("got span name %q, want db.Exec", span.Name())
+	}
+	attrs := make(map[string]string)
+	for _, kv := range span.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.Emit()
+		for _, arg := range []string{"Jane", "jane@example.com"} {
+			if strings.Contains(kv.Value.Emit(), arg) {
+				t.Errorf("attribute %s records argument %q", kv.Key, arg)
+			}
+		}
+	}
+	for key, want := range map[string]string{
+		string(semconv.DBStatementKey): query,
+		string(dbArgsCountKey):         "2",
+		string(dbRowsAffectedKey):      "3",
+	} {
+		if got := attrs[key]; got != want {
+			t.Errorf("got %s %q, want %q", key, got, want)
+		}
+	}
+	if span.Status().Code != codes.Unset {
+		t.Errorf("got status %v, want unset", span.Status())
+	}
+}
+
+func TestTracingDriverError(t *testing.T) {
+	drv, rec := newRecordedDriver(&stubDriver{err: errors.New("boom")})
+	if err := drv.Query(context.Background(), `SELECT 1`, []any{}, nil); err == nil {
+		t.Fatal("got no error")
+	}
+	spans := rec.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	if status := spans[0].Status(); status.Code != codes.Error || status.Description != "boom" {
+		t.Errorf("got status %v, want error boom", status)
+	}
+	if events := spans[0].Events(); len(events) != 1 || events[0].Name != "exception" {
+		t.Errorf("got events %v, want the recorded error", events)
+	}
+}
+
+func TestTracingDriverTx(t *testing.T) {
+	drv, rec := newRecordedDriver(&stubDriver{})
+	tx, err := drv.Tx(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var res sql.Result
+	if err := tx.Exec(context.Background(), `DELETE FROM "blogs"`, []any{}, &res); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Query(context.Background(), `SELECT 1`, []any{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	spans := rec.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("got %d spans, want 3", len(spans))
+	}
+	txSpan := spans[2]
+	if txSpan.Name() != "db.Tx" {
+		t.Fatalf("got last span %q, want db.Tx", txSpan.Name())
+	}
+	for i, name := range []string{"db.Tx.Exec", "db.Tx.Query"} {
+		if spans[i].Name() != name {
+			t.Errorf("got span %q, want %q", spans[i].Name(), name)
+		}
+		if spans[i].Parent().SpanID() != txSpan.SpanContext().SpanID() {
+			t.Errorf("span %q is not a child of the transaction span", spans[i].Name())
+		}
+	}
+	if events := txSpan.Events(); len(events) != 1 || events[0].Name != "commit" {
+		t.Errorf("got events %v, want commit", events)
+	}
+}